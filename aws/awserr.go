@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
@@ -35,52 +34,16 @@ func isAWSErrRequestFailureStatusCode(err error, statusCode int) bool {
 	return false
 }
 
+// retryOnAwsCode retries an AWS error code for two minutes
+//
+// Deprecated: Use tfresource.RetryWhenAwsErrCodeEquals instead.
 func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
-	var resp interface{}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
-		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok && awsErr.Code() == code {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		resp, err = f()
-	}
-
-	return resp, err
+	return tfresource.RetryWhenAwsErrCodeEquals(2*time.Minute, f, code)
 }
 
 // RetryOnAwsCodes retries AWS error codes for one minute
-// Note: This function will be moved out of the aws package in the future.
+//
+// Deprecated: Use tfresource.RetryWhenAwsErrCodeEquals instead.
 func RetryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}, error) {
-	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
-		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok {
-				for _, code := range codes {
-					if awsErr.Code() == code {
-						return resource.RetryableError(err)
-					}
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		resp, err = f()
-	}
-
-	return resp, err
+	return tfresource.RetryWhenAwsErrCodeEquals(1*time.Minute, f, codes...)
 }
@@ -1,171 +1,38 @@
 package aws
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
-	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/service/accessanalyzer"
-	"github.com/aws/aws-sdk-go/service/acm"
-	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go/service/applicationinsights"
-	"github.com/aws/aws-sdk-go/service/appmesh"
-	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/aws/aws-sdk-go/service/appsync"
-	"github.com/aws/aws-sdk-go/service/athena"
-	"github.com/aws/aws-sdk-go/service/autoscaling"
-	"github.com/aws/aws-sdk-go/service/autoscalingplans"
-	"github.com/aws/aws-sdk-go/service/backup"
-	"github.com/aws/aws-sdk-go/service/batch"
-	"github.com/aws/aws-sdk-go/service/budgets"
-	"github.com/aws/aws-sdk-go/service/cloud9"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/aws/aws-sdk-go/service/cloudfront"
-	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
-	"github.com/aws/aws-sdk-go/service/cloudsearch"
-	"github.com/aws/aws-sdk-go/service/cloudtrail"
-	"github.com/aws/aws-sdk-go/service/cloudwatch"
-	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	"github.com/aws/aws-sdk-go/service/codeartifact"
-	"github.com/aws/aws-sdk-go/service/codebuild"
-	"github.com/aws/aws-sdk-go/service/codecommit"
-	"github.com/aws/aws-sdk-go/service/codedeploy"
-	"github.com/aws/aws-sdk-go/service/codepipeline"
-	"github.com/aws/aws-sdk-go/service/codestarconnections"
-	"github.com/aws/aws-sdk-go/service/codestarnotifications"
-	"github.com/aws/aws-sdk-go/service/cognitoidentity"
-	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
-	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
-	"github.com/aws/aws-sdk-go/service/dataexchange"
-	"github.com/aws/aws-sdk-go/service/datapipeline"
-	"github.com/aws/aws-sdk-go/service/datasync"
-	"github.com/aws/aws-sdk-go/service/dax"
-	"github.com/aws/aws-sdk-go/service/devicefarm"
-	"github.com/aws/aws-sdk-go/service/directconnect"
-	"github.com/aws/aws-sdk-go/service/directoryservice"
-	"github.com/aws/aws-sdk-go/service/dlm"
-	"github.com/aws/aws-sdk-go/service/docdb"
-	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/ecr"
-	"github.com/aws/aws-sdk-go/service/ecrpublic"
-	"github.com/aws/aws-sdk-go/service/ecs"
-	"github.com/aws/aws-sdk-go/service/efs"
-	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/aws/aws-sdk-go/service/elasticache"
-	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
-	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
-	"github.com/aws/aws-sdk-go/service/elastictranscoder"
-	"github.com/aws/aws-sdk-go/service/elb"
-	"github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/aws/aws-sdk-go/service/emr"
-	"github.com/aws/aws-sdk-go/service/firehose"
-	"github.com/aws/aws-sdk-go/service/fms"
-	"github.com/aws/aws-sdk-go/service/forecastservice"
-	"github.com/aws/aws-sdk-go/service/fsx"
-	"github.com/aws/aws-sdk-go/service/gamelift"
-	"github.com/aws/aws-sdk-go/service/glacier"
-	"github.com/aws/aws-sdk-go/service/globalaccelerator"
-	"github.com/aws/aws-sdk-go/service/glue"
-	"github.com/aws/aws-sdk-go/service/greengrass"
-	"github.com/aws/aws-sdk-go/service/guardduty"
-	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/aws/aws-sdk-go/service/identitystore"
-	"github.com/aws/aws-sdk-go/service/imagebuilder"
-	"github.com/aws/aws-sdk-go/service/inspector"
-	"github.com/aws/aws-sdk-go/service/iot"
-	"github.com/aws/aws-sdk-go/service/iotanalytics"
-	"github.com/aws/aws-sdk-go/service/iotevents"
-	"github.com/aws/aws-sdk-go/service/kafka"
-	"github.com/aws/aws-sdk-go/service/kinesis"
-	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
-	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
-	"github.com/aws/aws-sdk-go/service/kinesisvideo"
-	"github.com/aws/aws-sdk-go/service/kms"
-	"github.com/aws/aws-sdk-go/service/lakeformation"
-	"github.com/aws/aws-sdk-go/service/lambda"
-	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
-	"github.com/aws/aws-sdk-go/service/licensemanager"
-	"github.com/aws/aws-sdk-go/service/lightsail"
-	"github.com/aws/aws-sdk-go/service/macie"
-	"github.com/aws/aws-sdk-go/service/macie2"
-	"github.com/aws/aws-sdk-go/service/managedblockchain"
-	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
-	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
-	"github.com/aws/aws-sdk-go/service/medialive"
-	"github.com/aws/aws-sdk-go/service/mediapackage"
-	"github.com/aws/aws-sdk-go/service/mediastore"
-	"github.com/aws/aws-sdk-go/service/mediastoredata"
-	"github.com/aws/aws-sdk-go/service/mq"
-	"github.com/aws/aws-sdk-go/service/mwaa"
-	"github.com/aws/aws-sdk-go/service/neptune"
-	"github.com/aws/aws-sdk-go/service/networkfirewall"
-	"github.com/aws/aws-sdk-go/service/networkmanager"
-	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/organizations"
-	"github.com/aws/aws-sdk-go/service/outposts"
-	"github.com/aws/aws-sdk-go/service/personalize"
-	"github.com/aws/aws-sdk-go/service/pinpoint"
-	"github.com/aws/aws-sdk-go/service/pricing"
-	"github.com/aws/aws-sdk-go/service/qldb"
-	"github.com/aws/aws-sdk-go/service/quicksight"
-	"github.com/aws/aws-sdk-go/service/ram"
-	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/aws/aws-sdk-go/service/redshift"
-	"github.com/aws/aws-sdk-go/service/resourcegroups"
-	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
-	"github.com/aws/aws-sdk-go/service/route53"
-	"github.com/aws/aws-sdk-go/service/route53domains"
-	"github.com/aws/aws-sdk-go/service/route53resolver"
-	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3control"
-	"github.com/aws/aws-sdk-go/service/s3outposts"
-	"github.com/aws/aws-sdk-go/service/sagemaker"
-	"github.com/aws/aws-sdk-go/service/secretsmanager"
-	"github.com/aws/aws-sdk-go/service/securityhub"
-	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
-	"github.com/aws/aws-sdk-go/service/servicecatalog"
-	"github.com/aws/aws-sdk-go/service/servicediscovery"
-	"github.com/aws/aws-sdk-go/service/servicequotas"
-	"github.com/aws/aws-sdk-go/service/ses"
-	"github.com/aws/aws-sdk-go/service/sfn"
-	"github.com/aws/aws-sdk-go/service/shield"
-	"github.com/aws/aws-sdk-go/service/signer"
-	"github.com/aws/aws-sdk-go/service/simpledb"
-	"github.com/aws/aws-sdk-go/service/sns"
-	"github.com/aws/aws-sdk-go/service/sqs"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/aws/aws-sdk-go/service/ssoadmin"
-	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
-	"github.com/aws/aws-sdk-go/service/swf"
-	"github.com/aws/aws-sdk-go/service/synthetics"
-	"github.com/aws/aws-sdk-go/service/timestreamwrite"
-	"github.com/aws/aws-sdk-go/service/transfer"
-	"github.com/aws/aws-sdk-go/service/waf"
-	"github.com/aws/aws-sdk-go/service/wafregional"
-	"github.com/aws/aws-sdk-go/service/wafv2"
-	"github.com/aws/aws-sdk-go/service/worklink"
-	"github.com/aws/aws-sdk-go/service/workmail"
-	"github.com/aws/aws-sdk-go/service/workspaces"
-	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apimetrics"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/requestlog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retrypolicy"
 )
 
 type Config struct {
@@ -177,6 +44,8 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
+	RetryPolicies map[string]*retrypolicy.Policy
+
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
 	AssumeRoleExternalID        string
@@ -186,12 +55,31 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	AssumeRoleWithWebIdentityDurationSeconds int
+	AssumeRoleWithWebIdentityPolicy          string
+	AssumeRoleWithWebIdentityPolicyARNs      []string
+	AssumeRoleWithWebIdentityRoleARN         string
+	AssumeRoleWithWebIdentitySessionName     string
+	AssumeRoleWithWebIdentityToken           string
+	AssumeRoleWithWebIdentityTokenFile       string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints        map[string]string
-	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
-	Insecure         bool
+	CustomCABundle    string
+	DefaultTagsConfig *keyvaluetags.DefaultConfig
+	EmulatorEndpoint  string
+	Endpoints         map[string]string
+	GlobalServices    []*GlobalServiceConfig
+	HTTPProxy         string
+	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+	Insecure          bool
+	MinTLSVersion     string
+
+	TagPolicyFile         string
+	TagPolicyRequiredKeys []string
+	TagPolicyTargetID     string
+	UseTagPolicy          bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -199,173 +87,111 @@ type Config struct {
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
+	UseDualStackEndpoint    bool
+	UseFIPSEndpoint         bool
 
 	terraformVersion string
 }
 
 type AWSClient struct {
-	accessanalyzerconn                  *accessanalyzer.AccessAnalyzer
-	accountid                           string
-	acmconn                             *acm.ACM
-	acmpcaconn                          *acmpca.ACMPCA
-	amplifyconn                         *amplify.Amplify
-	apigatewayconn                      *apigateway.APIGateway
-	apigatewayv2conn                    *apigatewayv2.ApiGatewayV2
-	appautoscalingconn                  *applicationautoscaling.ApplicationAutoScaling
-	applicationinsightsconn             *applicationinsights.ApplicationInsights
-	appmeshconn                         *appmesh.AppMesh
-	appstreamconn                       *appstream.AppStream
-	appsyncconn                         *appsync.AppSync
-	athenaconn                          *athena.Athena
-	autoscalingconn                     *autoscaling.AutoScaling
-	autoscalingplansconn                *autoscalingplans.AutoScalingPlans
-	backupconn                          *backup.Backup
-	batchconn                           *batch.Batch
-	budgetconn                          *budgets.Budgets
-	cfconn                              *cloudformation.CloudFormation
-	cloud9conn                          *cloud9.Cloud9
-	cloudfrontconn                      *cloudfront.CloudFront
-	cloudhsmv2conn                      *cloudhsmv2.CloudHSMV2
-	cloudsearchconn                     *cloudsearch.CloudSearch
-	cloudtrailconn                      *cloudtrail.CloudTrail
-	cloudwatchconn                      *cloudwatch.CloudWatch
-	cloudwatcheventsconn                *cloudwatchevents.CloudWatchEvents
-	cloudwatchlogsconn                  *cloudwatchlogs.CloudWatchLogs
-	codeartifactconn                    *codeartifact.CodeArtifact
-	codebuildconn                       *codebuild.CodeBuild
-	codecommitconn                      *codecommit.CodeCommit
-	codedeployconn                      *codedeploy.CodeDeploy
-	codepipelineconn                    *codepipeline.CodePipeline
-	codestarconnectionsconn             *codestarconnections.CodeStarConnections
-	codestarnotificationsconn           *codestarnotifications.CodeStarNotifications
-	cognitoconn                         *cognitoidentity.CognitoIdentity
-	cognitoidpconn                      *cognitoidentityprovider.CognitoIdentityProvider
-	configconn                          *configservice.ConfigService
-	connectconn                         *connect.Connect
-	costandusagereportconn              *costandusagereportservice.CostandUsageReportService
-	dataexchangeconn                    *dataexchange.DataExchange
-	datapipelineconn                    *datapipeline.DataPipeline
-	datasyncconn                        *datasync.DataSync
-	daxconn                             *dax.DAX
-	devicefarmconn                      *devicefarm.DeviceFarm
-	dlmconn                             *dlm.DLM
-	dmsconn                             *databasemigrationservice.DatabaseMigrationService
-	dnsSuffix                           string
-	docdbconn                           *docdb.DocDB
-	dsconn                              *directoryservice.DirectoryService
-	dxconn                              *directconnect.DirectConnect
-	dynamodbconn                        *dynamodb.DynamoDB
-	ec2conn                             *ec2.EC2
-	ecrconn                             *ecr.ECR
-	ecrpublicconn                       *ecrpublic.ECRPublic
-	ecsconn                             *ecs.ECS
-	efsconn                             *efs.EFS
-	eksconn                             *eks.EKS
-	elasticacheconn                     *elasticache.ElastiCache
-	elasticbeanstalkconn                *elasticbeanstalk.ElasticBeanstalk
-	elastictranscoderconn               *elastictranscoder.ElasticTranscoder
-	elbconn                             *elb.ELB
-	elbv2conn                           *elbv2.ELBV2
-	emrconn                             *emr.EMR
-	esconn                              *elasticsearch.ElasticsearchService
-	firehoseconn                        *firehose.Firehose
-	fmsconn                             *fms.FMS
-	forecastconn                        *forecastservice.ForecastService
-	fsxconn                             *fsx.FSx
-	gameliftconn                        *gamelift.GameLift
-	glacierconn                         *glacier.Glacier
-	globalacceleratorconn               *globalaccelerator.GlobalAccelerator
-	glueconn                            *glue.Glue
-	guarddutyconn                       *guardduty.GuardDuty
-	greengrassconn                      *greengrass.Greengrass
-	iamconn                             *iam.IAM
-	identitystoreconn                   *identitystore.IdentityStore
-	IgnoreTagsConfig                    *keyvaluetags.IgnoreConfig
-	imagebuilderconn                    *imagebuilder.Imagebuilder
-	inspectorconn                       *inspector.Inspector
-	iotconn                             *iot.IoT
-	iotanalyticsconn                    *iotanalytics.IoTAnalytics
-	ioteventsconn                       *iotevents.IoTEvents
-	kafkaconn                           *kafka.Kafka
-	kinesisanalyticsconn                *kinesisanalytics.KinesisAnalytics
-	kinesisanalyticsv2conn              *kinesisanalyticsv2.KinesisAnalyticsV2
-	kinesisconn                         *kinesis.Kinesis
-	kinesisvideoconn                    *kinesisvideo.KinesisVideo
-	kmsconn                             *kms.KMS
-	lakeformationconn                   *lakeformation.LakeFormation
-	lambdaconn                          *lambda.Lambda
-	lexmodelconn                        *lexmodelbuildingservice.LexModelBuildingService
-	licensemanagerconn                  *licensemanager.LicenseManager
-	lightsailconn                       *lightsail.Lightsail
-	macieconn                           *macie.Macie
-	macie2conn                          *macie2.Macie2
-	managedblockchainconn               *managedblockchain.ManagedBlockchain
-	marketplacecatalogconn              *marketplacecatalog.MarketplaceCatalog
-	mediaconnectconn                    *mediaconnect.MediaConnect
-	mediaconvertconn                    *mediaconvert.MediaConvert
-	mediaconvertaccountconn             *mediaconvert.MediaConvert
-	medialiveconn                       *medialive.MediaLive
-	mediapackageconn                    *mediapackage.MediaPackage
-	mediastoreconn                      *mediastore.MediaStore
-	mediastoredataconn                  *mediastoredata.MediaStoreData
-	mqconn                              *mq.MQ
-	mwaaconn                            *mwaa.MWAA
-	neptuneconn                         *neptune.Neptune
-	networkfirewallconn                 *networkfirewall.NetworkFirewall
-	networkmanagerconn                  *networkmanager.NetworkManager
-	opsworksconn                        *opsworks.OpsWorks
-	organizationsconn                   *organizations.Organizations
-	outpostsconn                        *outposts.Outposts
-	partition                           string
-	personalizeconn                     *personalize.Personalize
-	pinpointconn                        *pinpoint.Pinpoint
-	pricingconn                         *pricing.Pricing
-	qldbconn                            *qldb.QLDB
-	quicksightconn                      *quicksight.QuickSight
-	r53conn                             *route53.Route53
-	ramconn                             *ram.RAM
-	rdsconn                             *rds.RDS
-	redshiftconn                        *redshift.Redshift
-	region                              string
-	resourcegroupsconn                  *resourcegroups.ResourceGroups
-	resourcegroupstaggingapiconn        *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
-	route53domainsconn                  *route53domains.Route53Domains
-	route53resolverconn                 *route53resolver.Route53Resolver
-	s3conn                              *s3.S3
-	s3connUriCleaningDisabled           *s3.S3
-	s3controlconn                       *s3control.S3Control
-	s3outpostsconn                      *s3outposts.S3Outposts
-	sagemakerconn                       *sagemaker.SageMaker
-	scconn                              *servicecatalog.ServiceCatalog
-	sdconn                              *servicediscovery.ServiceDiscovery
-	secretsmanagerconn                  *secretsmanager.SecretsManager
-	securityhubconn                     *securityhub.SecurityHub
-	serverlessapplicationrepositoryconn *serverlessapplicationrepository.ServerlessApplicationRepository
-	servicequotasconn                   *servicequotas.ServiceQuotas
-	sesconn                             *ses.SES
-	sfnconn                             *sfn.SFN
-	shieldconn                          *shield.Shield
-	signerconn                          *signer.Signer
-	simpledbconn                        *simpledb.SimpleDB
-	snsconn                             *sns.SNS
-	sqsconn                             *sqs.SQS
-	ssmconn                             *ssm.SSM
-	ssoadminconn                        *ssoadmin.SSOAdmin
-	storagegatewayconn                  *storagegateway.StorageGateway
-	stsconn                             *sts.STS
-	supportedplatforms                  []string
-	swfconn                             *swf.SWF
-	syntheticsconn                      *synthetics.Synthetics
-	terraformVersion                    string
-	timestreamwriteconn                 *timestreamwrite.TimestreamWrite
-	transferconn                        *transfer.Transfer
-	wafconn                             *waf.WAF
-	wafregionalconn                     *wafregional.WAFRegional
-	wafv2conn                           *wafv2.WAFV2
-	worklinkconn                        *worklink.WorkLink
-	workmailconn                        *workmail.WorkMail
-	workspacesconn                      *workspaces.WorkSpaces
-	xrayconn                            *xray.XRay
+	accountid               string
+	conns                   map[string]interface{}
+	connsMutex              sync.Mutex
+	DefaultTagsConfig       *keyvaluetags.DefaultConfig
+	dnsSuffix               string
+	emulatorEndpoint        string
+	endpoints               map[string]string
+	globalServices          map[string]*GlobalServiceConfig
+	IgnoreTagsConfig        *keyvaluetags.IgnoreConfig
+	maxRetries              int
+	mediaconvertaccountconn *mediaconvert.MediaConvert
+	partition               string
+	region                  string
+	retryPolicies           map[string]*retrypolicy.Policy
+	s3ForcePathStyle        bool
+	session                 *session.Session
+	supportedplatforms      []string
+	TagPolicyConfig         *keyvaluetags.TagPolicyConfig
+	terraformVersion        string
+	useDualStackEndpoint    bool
+	useFIPSEndpoint         bool
+}
+
+// conn returns the service client cached under the given key,
+// calling newConn to create it on first use.
+func (client *AWSClient) conn(key string, newConn func() interface{}) interface{} {
+	client.connsMutex.Lock()
+	defer client.connsMutex.Unlock()
+
+	if conn, ok := client.conns[key]; ok {
+		return conn
+	}
+
+	if client.conns == nil {
+		client.conns = make(map[string]interface{})
+	}
+
+	conn := newConn()
+	client.conns[key] = conn
+
+	return conn
+}
+
+// serviceSession returns a copy of the provider session for the service with the given
+// endpoints key. Any endpoint override, global service routing and retry policy for the
+// service are applied, followed by the given configurations. Without an endpoint
+// override, FIPS and dual-stack endpoints are resolved if enabled.
+func (client *AWSClient) serviceSession(key string, cfgs ...*aws.Config) *session.Session {
+	policy := client.retryPolicies[key]
+
+	endpoint := client.endpoint(key)
+
+	config := &aws.Config{
+		Endpoint: aws.String(endpoint),
+	}
+
+	if globalService, ok := client.globalServices[key]; ok {
+		config.Region = aws.String(globalService.Region)
+	}
+
+	if endpoint == "" {
+		if client.useFIPSEndpoint {
+			config.EndpointResolver = fipsEndpointResolver{resolver: endpoints.DefaultResolver()}
+		}
+
+		if client.useDualStackEndpoint {
+			config.UseDualStack = aws.Bool(true)
+		}
+	}
+
+	if retryer := policy.Retryer(client.maxRetries); retryer != nil {
+		config.Retryer = retryer
+	}
+
+	sess := client.session.Copy(append([]*aws.Config{config}, cfgs...)...)
+
+	policy.Install(&sess.Handlers)
+
+	return sess
+}
+
+// endpoint returns the custom endpoint for the service with the given endpoints key,
+// falling back to the emulator endpoint and then the global service endpoint.
+// An empty string is returned if none are set.
+func (client *AWSClient) endpoint(key string) string {
+	if v := client.endpoints[key]; v != "" {
+		return v
+	}
+
+	if client.emulatorEndpoint != "" {
+		return client.emulatorEndpoint
+	}
+
+	if globalService, ok := client.globalServices[key]; ok {
+		return globalService.Endpoint
+	}
+
+	return ""
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -384,6 +210,20 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
+	// Local AWS emulators accept any credentials and do not implement the
+	// APIs used for credential validation and account ID lookup.
+	if c.EmulatorEndpoint != "" {
+		if c.AccessKey == "" && c.SecretKey == "" && c.Profile == "" {
+			c.AccessKey = "mock_access_key"
+			c.SecretKey = "mock_secret_key"
+		}
+
+		c.S3ForcePathStyle = true
+		c.SkipCredsValidation = true
+		c.SkipMetadataApiCheck = true
+		c.SkipRequestingAccountId = true
+	}
+
 	// Get the auth and region. This can fail if keys/regions were not
 	// specified and we're attempting to use the environment.
 	if !c.SkipRegionValidation {
@@ -392,8 +232,45 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: error configuring HTTP client: %w", err)
+	}
+
+	accessKey, secretKey, token := c.AccessKey, c.SecretKey, c.Token
+	iamEndpoint, stsEndpoint := c.Endpoints["iam"], c.Endpoints["sts"]
+
+	if iamEndpoint == "" {
+		iamEndpoint = c.EmulatorEndpoint
+	}
+
+	if stsEndpoint == "" {
+		stsEndpoint = c.EmulatorEndpoint
+	}
+
+	if c.UseFIPSEndpoint && stsEndpoint == "" {
+		resolver := fipsEndpointResolver{resolver: endpoints.DefaultResolver()}
+
+		if endpoint, err := resolver.EndpointFor(sts.EndpointsID, c.Region); err == nil {
+			stsEndpoint = endpoint.URL
+		}
+	}
+
+	if c.AssumeRoleWithWebIdentityRoleARN != "" {
+		creds, err := c.assumeRoleWithWebIdentity(httpClient, stsEndpoint)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: error assuming IAM Role (%s) with web identity: %w", c.AssumeRoleWithWebIdentityRoleARN, err)
+		}
+
+		accessKey = aws.StringValue(creds.AccessKeyId)
+		secretKey = aws.StringValue(creds.SecretAccessKey)
+		token = aws.StringValue(creds.SessionToken)
+	}
+
 	awsbaseConfig := &awsbase.Config{
-		AccessKey:                   c.AccessKey,
+		AccessKey:                   accessKey,
 		AssumeRoleARN:               c.AssumeRoleARN,
 		AssumeRoleDurationSeconds:   c.AssumeRoleDurationSeconds,
 		AssumeRoleExternalID:        c.AssumeRoleExternalID,
@@ -405,18 +282,18 @@ func (c *Config) Client() (interface{}, error) {
 		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:                  "Terraform AWS Provider",
 		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints["iam"],
+		DebugLogging:                logging.LogLevel() == "TRACE",
+		IamEndpoint:                 iamEndpoint,
 		Insecure:                    c.Insecure,
 		MaxRetries:                  c.MaxRetries,
 		Profile:                     c.Profile,
 		Region:                      c.Region,
-		SecretKey:                   c.SecretKey,
+		SecretKey:                   secretKey,
 		SkipCredsValidation:         c.SkipCredsValidation,
 		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
 		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints["sts"],
-		Token:                       c.Token,
+		StsEndpoint:                 stsEndpoint,
+		Token:                       token,
 		UserAgentProducts: []*awsbase.UserAgentProduct{
 			{Name: "APN", Version: "1.0"},
 			{Name: "HashiCorp", Version: "1.0"},
@@ -430,6 +307,21 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	// Service client sessions are copied from this session and inherit the
+	// HTTP client. Requests made while the session is created, such as
+	// credential validation, use the default HTTP client.
+	if httpClient != nil {
+		sess.Config.HTTPClient = httpClient
+	}
+
+	// Raw request and response bodies can contain secrets, so they are only
+	// logged at TRACE level. DEBUG level logs a redacted summary of every request.
+	if logging.IsDebugOrHigher() {
+		requestlog.Install(&sess.Handlers)
+	}
+
+	apiMetrics.Install(&sess.Handlers)
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -444,363 +336,378 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	client := &AWSClient{
-		accessanalyzerconn:                  accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["accessanalyzer"])})),
-		accountid:                           accountID,
-		acmconn:                             acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])})),
-		acmpcaconn:                          acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acmpca"])})),
-		amplifyconn:                         amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["amplify"])})),
-		apigatewayconn:                      apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		apigatewayv2conn:                    apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		appautoscalingconn:                  applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationautoscaling"])})),
-		applicationinsightsconn:             applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationinsights"])})),
-		appmeshconn:                         appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appmesh"])})),
-		appstreamconn:                       appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appstream"])})),
-		appsyncconn:                         appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appsync"])})),
-		athenaconn:                          athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["athena"])})),
-		autoscalingconn:                     autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscaling"])})),
-		autoscalingplansconn:                autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscalingplans"])})),
-		backupconn:                          backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["backup"])})),
-		batchconn:                           batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["batch"])})),
-		budgetconn:                          budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["budgets"])})),
-		cfconn:                              cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudformation"])})),
-		cloud9conn:                          cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloud9"])})),
-		cloudfrontconn:                      cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudfront"])})),
-		cloudhsmv2conn:                      cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudhsm"])})),
-		cloudsearchconn:                     cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudsearch"])})),
-		cloudtrailconn:                      cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudtrail"])})),
-		cloudwatchconn:                      cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatch"])})),
-		cloudwatcheventsconn:                cloudwatchevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchevents"])})),
-		cloudwatchlogsconn:                  cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchlogs"])})),
-		codeartifactconn:                    codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codeartifact"])})),
-		codebuildconn:                       codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codebuild"])})),
-		codecommitconn:                      codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codecommit"])})),
-		codedeployconn:                      codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codedeploy"])})),
-		codepipelineconn:                    codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codepipeline"])})),
-		codestarconnectionsconn:             codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codestarconnections"])})),
-		codestarnotificationsconn:           codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codestarnotifications"])})),
-		cognitoconn:                         cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidentity"])})),
-		cognitoidpconn:                      cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])})),
-		configconn:                          configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])})),
-		connectconn:                         connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["connect"])})),
-		costandusagereportconn:              costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])})),
-		dataexchangeconn:                    dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dataexchange"])})),
-		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
-		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
-		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
-		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
-		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
-		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
-		dnsSuffix:                           dnsSuffix,
-		docdbconn:                           docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["docdb"])})),
-		dsconn:                              directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ds"])})),
-		dxconn:                              directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["directconnect"])})),
-		dynamodbconn:                        dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dynamodb"])})),
-		ec2conn:                             ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ec2"])})),
-		ecrconn:                             ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecr"])})),
-		ecrpublicconn:                       ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecrpublic"])})),
-		ecsconn:                             ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecs"])})),
-		efsconn:                             efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["efs"])})),
-		eksconn:                             eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["eks"])})),
-		elasticacheconn:                     elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticache"])})),
-		elasticbeanstalkconn:                elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticbeanstalk"])})),
-		elastictranscoderconn:               elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elastictranscoder"])})),
-		elbconn:                             elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		elbv2conn:                           elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		emrconn:                             emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emr"])})),
-		esconn:                              elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["es"])})),
-		firehoseconn:                        firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["firehose"])})),
-		fmsconn:                             fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fms"])})),
-		forecastconn:                        forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["forecast"])})),
-		fsxconn:                             fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fsx"])})),
-		gameliftconn:                        gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["gamelift"])})),
-		glacierconn:                         glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glacier"])})),
-		glueconn:                            glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glue"])})),
-		guarddutyconn:                       guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["guardduty"])})),
-		greengrassconn:                      greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["greengrass"])})),
-		iamconn:                             iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])})),
-		identitystoreconn:                   identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["identitystore"])})),
-		IgnoreTagsConfig:                    c.IgnoreTagsConfig,
-		imagebuilderconn:                    imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["imagebuilder"])})),
-		inspectorconn:                       inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["inspector"])})),
-		iotconn:                             iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iot"])})),
-		iotanalyticsconn:                    iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotanalytics"])})),
-		ioteventsconn:                       iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotevents"])})),
-		kafkaconn:                           kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kafka"])})),
-		kinesisanalyticsconn:                kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalytics"])})),
-		kinesisanalyticsv2conn:              kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalyticsv2"])})),
-		kinesisconn:                         kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis"])})),
-		kinesisvideoconn:                    kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisvideo"])})),
-		kmsconn:                             kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kms"])})),
-		lakeformationconn:                   lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lakeformation"])})),
-		lambdaconn:                          lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lambda"])})),
-		lexmodelconn:                        lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lexmodels"])})),
-		licensemanagerconn:                  licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["licensemanager"])})),
-		lightsailconn:                       lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lightsail"])})),
-		macieconn:                           macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie"])})),
-		macie2conn:                          macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie2"])})),
-		managedblockchainconn:               managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["managedblockchain"])})),
-		marketplacecatalogconn:              marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["marketplacecatalog"])})),
-		mediaconnectconn:                    mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconnect"])})),
-		mediaconvertconn:                    mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconvert"])})),
-		medialiveconn:                       medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["medialive"])})),
-		mediapackageconn:                    mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediapackage"])})),
-		mediastoreconn:                      mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastore"])})),
-		mediastoredataconn:                  mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastoredata"])})),
-		mqconn:                              mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mq"])})),
-		mwaaconn:                            mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mwaa"])})),
-		neptuneconn:                         neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["neptune"])})),
-		networkfirewallconn:                 networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkfirewall"])})),
-		networkmanagerconn:                  networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkmanager"])})),
-		opsworksconn:                        opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["opsworks"])})),
-		organizationsconn:                   organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])})),
-		outpostsconn:                        outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["outposts"])})),
-		partition:                           partition,
-		personalizeconn:                     personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["personalize"])})),
-		pinpointconn:                        pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pinpoint"])})),
-		pricingconn:                         pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])})),
-		qldbconn:                            qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["qldb"])})),
-		quicksightconn:                      quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["quicksight"])})),
-		ramconn:                             ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
-		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
-		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
-		region:                              c.Region,
-		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
-		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
-		route53domainsconn:                  route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53domains"])})),
-		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
-		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
-		s3outpostsconn:                      s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3outposts"])})),
-		sagemakerconn:                       sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sagemaker"])})),
-		scconn:                              servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicecatalog"])})),
-		sdconn:                              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicediscovery"])})),
-		secretsmanagerconn:                  secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["secretsmanager"])})),
-		securityhubconn:                     securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["securityhub"])})),
-		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])})),
-		servicequotasconn:                   servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicequotas"])})),
-		sesconn:                             ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])})),
-		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
-		signerconn:                          signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["signer"])})),
-		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
-		snsconn:                             sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sns"])})),
-		sqsconn:                             sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sqs"])})),
-		ssmconn:                             ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssm"])})),
-		ssoadminconn:                        ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssoadmin"])})),
-		storagegatewayconn:                  storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["storagegateway"])})),
-		stsconn:                             sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})),
-		swfconn:                             swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["swf"])})),
-		syntheticsconn:                      synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["synthetics"])})),
-		terraformVersion:                    c.terraformVersion,
-		timestreamwriteconn:                 timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["timestreamwrite"])})),
-		transferconn:                        transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["transfer"])})),
-		wafconn:                             waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["waf"])})),
-		wafregionalconn:                     wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafregional"])})),
-		wafv2conn:                           wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafv2"])})),
-		worklinkconn:                        worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["worklink"])})),
-		workmailconn:                        workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workmail"])})),
-		workspacesconn:                      workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workspaces"])})),
-		xrayconn:                            xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["xray"])})),
-	}
-
-	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["globalaccelerator"]),
-	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["route53"]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["shield"]),
-	}
-
-	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints["s3"]),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
-	}
-
-	client.s3conn = s3.New(sess.Copy(s3Config))
-
-	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.s3connUriCleaningDisabled = s3.New(sess.Copy(s3Config))
-
-	// Force "global" services to correct regions
-	switch partition {
-	case endpoints.AwsPartitionID:
-		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
-		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
-	case endpoints.AwsCnPartitionID:
-		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
-		// This can likely be removed in the future.
-		if aws.StringValue(route53Config.Endpoint) == "" {
-			route53Config.Endpoint = aws.String("https://api.route53.cn")
-		}
-		route53Config.Region = aws.String(endpoints.CnNorthwest1RegionID)
-	case endpoints.AwsUsGovPartitionID:
-		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		accountid:            accountID,
+		conns:                make(map[string]interface{}),
+		DefaultTagsConfig:    c.DefaultTagsConfig,
+		dnsSuffix:            dnsSuffix,
+		emulatorEndpoint:     c.EmulatorEndpoint,
+		endpoints:            c.Endpoints,
+		globalServices:       globalServicesForPartition(partition, c.GlobalServices),
+		IgnoreTagsConfig:     c.IgnoreTagsConfig,
+		maxRetries:           c.MaxRetries,
+		partition:            partition,
+		region:               c.Region,
+		retryPolicies:        make(map[string]*retrypolicy.Policy),
+		s3ForcePathStyle:     c.S3ForcePathStyle,
+		session:              sess,
+		terraformVersion:     c.terraformVersion,
+		useDualStackEndpoint: c.UseDualStackEndpoint,
+		useFIPSEndpoint:      c.UseFIPSEndpoint,
 	}
 
-	client.globalacceleratorconn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.r53conn = route53.New(sess.Copy(route53Config))
-	client.shieldconn = shield.New(sess.Copy(shieldConfig))
+	for key, policy := range defaultRetryPolicies() {
+		client.retryPolicies[key] = policy
+	}
 
-	client.apigatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	for key, policy := range c.RetryPolicies {
+		client.retryPolicies[key] = client.retryPolicies[key].Merge(policy)
+	}
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	if c.UseTagPolicy {
+		tagPolicyConfig, err := c.tagPolicyConfig(client.organizationsconn())
 
-	client.appsyncconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
+		if err != nil {
+			return nil, err
 		}
-	})
 
-	client.configconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
-				return
-			}
+		client.TagPolicyConfig = tagPolicyConfig
+	}
 
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn())
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.supportedplatforms = supportedPlatforms
 		}
-	})
+	}
 
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	return client, nil
+}
 
-	client.ec2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateClientVpnEndpoint" {
-			if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
+// apiMetrics collects AWS API call metrics for all provider configurations.
+var apiMetrics = apimetrics.NewCollector()
+
+// WriteAPIMetricsSummary writes a summary of the AWS API calls made by all provider
+// configurations, to the file named by the TF_AWS_API_METRICS_PATH environment
+// variable or to the log. It should be called once, when the provider process exits.
+func WriteAPIMetricsSummary() {
+	if err := apiMetrics.WriteSummary(os.Getenv(apimetrics.EnvPath)); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+}
+
+// httpClient returns the HTTP client for AWS API requests, configured with the
+// custom CA bundle, HTTP proxy and minimum TLS version. A nil client is returned
+// when none of these are set, leaving the AWS SDK default in place.
+func (c *Config) httpClient() (*http.Client, error) {
+	if c.CustomCABundle == "" && c.HTTPProxy == "" && c.MinTLSVersion == "" {
+		return nil, nil
+	}
+
+	client := cleanhttp.DefaultPooledClient()
+	transport := client.Transport.(*http.Transport)
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CustomCABundle != "" {
+		pem, err := ioutil.ReadFile(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", c.CustomCABundle, err)
 		}
 
-		if r.Operation.Name == "CreateVpnConnection" {
-			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
+		certPool := x509.NewCertPool()
+
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): no PEM encoded certificates found", c.CustomCABundle)
 		}
 
-		if r.Operation.Name == "CreateVpnGateway" {
-			if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL (%s): %w", c.HTTPProxy, err)
 		}
 
-		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
-			if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if c.MinTLSVersion != "" {
+		v, ok := tlsVersions[c.MinTLSVersion]
+
+		if !ok {
+			return nil, fmt.Errorf("unsupported minimum TLS version: %s", c.MinTLSVersion)
 		}
-	})
 
-	client.kafkaconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
+		tlsConfig.MinVersion = v
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return client, nil
+}
+
+// tlsVersions maps the minimum TLS version provider argument values to
+// crypto/tls versions.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// assumeRoleWithWebIdentity exchanges the configured web identity token for
+// temporary IAM Role credentials via the STS AssumeRoleWithWebIdentity API.
+// The API call is not signed, so no other credentials are required.
+func (c *Config) assumeRoleWithWebIdentity(httpClient *http.Client, stsEndpoint string) (*sts.Credentials, error) {
+	webIdentityToken := c.AssumeRoleWithWebIdentityToken
+
+	if c.AssumeRoleWithWebIdentityTokenFile != "" {
+		b, err := ioutil.ReadFile(c.AssumeRoleWithWebIdentityTokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading web identity token file (%s): %w", c.AssumeRoleWithWebIdentityTokenFile, err)
 		}
+
+		webIdentityToken = strings.TrimSpace(string(b))
+	}
+
+	if webIdentityToken == "" {
+		return nil, fmt.Errorf("web identity token is empty")
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(stsEndpoint),
+		HTTPClient:  httpClient,
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
 	})
 
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
+	if err != nil {
+		return nil, fmt.Errorf("error creating STS session: %w", err)
+	}
+
+	sessionName := c.AssumeRoleWithWebIdentitySessionName
+
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("terraform-%d", time.Now().UTC().UnixNano())
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(c.AssumeRoleWithWebIdentityRoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(webIdentityToken),
+	}
+
+	if c.AssumeRoleWithWebIdentityDurationSeconds != 0 {
+		input.DurationSeconds = aws.Int64(int64(c.AssumeRoleWithWebIdentityDurationSeconds))
+	}
+
+	if c.AssumeRoleWithWebIdentityPolicy != "" {
+		input.Policy = aws.String(c.AssumeRoleWithWebIdentityPolicy)
+	}
+
+	for _, policyARN := range c.AssumeRoleWithWebIdentityPolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	log.Printf("[DEBUG] Assuming IAM Role (%s) with web identity", c.AssumeRoleWithWebIdentityRoleARN)
+	output, err := sts.New(sess).AssumeRoleWithWebIdentity(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Credentials == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return output.Credentials, nil
+}
+
+// tagPolicyConfig loads the tag policy from the configured file or,
+// if no file is configured, the effective tag policy from AWS Organizations.
+func (c *Config) tagPolicyConfig(conn *organizations.Organizations) (*keyvaluetags.TagPolicyConfig, error) {
+	tagPolicyConfig := &keyvaluetags.TagPolicyConfig{
+		RequiredKeys: keyvaluetags.New(c.TagPolicyRequiredKeys),
+	}
+
+	var content string
+
+	if c.TagPolicyFile != "" {
+		b, err := ioutil.ReadFile(c.TagPolicyFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading tag policy (%s): %w", c.TagPolicyFile, err)
 		}
-	})
 
-	client.organizationsconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
+		content = string(b)
+	} else {
+		input := &organizations.DescribeEffectivePolicyInput{
+			PolicyType: aws.String(organizations.EffectivePolicyTypeTagPolicy),
 		}
-	})
 
-	client.storagegatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
+		if c.TagPolicyTargetID != "" {
+			input.TargetId = aws.String(c.TagPolicyTargetID)
 		}
-	})
 
-	client.wafv2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
+		output, err := conn.DescribeEffectivePolicy(input)
+
+		if tfawserr.ErrCodeEquals(err, organizations.ErrCodeEffectivePolicyNotFoundException) {
+			log.Printf("[WARN] No effective tag policy found in AWS Organizations, only checking required tag keys")
+			return tagPolicyConfig, nil
 		}
 
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
+		if err != nil {
+			return nil, fmt.Errorf("error reading effective tag policy from AWS Organizations: %w", err)
 		}
 
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-			if isAWSErr(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
+		if output == nil || output.EffectivePolicy == nil {
+			return nil, fmt.Errorf("error reading effective tag policy from AWS Organizations: empty response")
 		}
-	})
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.supportedplatforms = supportedPlatforms
+		content = aws.StringValue(output.EffectivePolicy.PolicyContent)
+	}
+
+	policy, err := keyvaluetags.NewTagPolicy(content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tagPolicyConfig.Policy = policy
+
+	return tagPolicyConfig, nil
+}
+
+// GlobalServiceConfig routes the service with the given endpoints key to a region and,
+// optionally, an endpoint in a partition. An empty Partition matches all partitions.
+type GlobalServiceConfig struct {
+	Endpoint  string
+	Partition string
+	Region    string
+	Service   string
+}
+
+// defaultGlobalServices routes global services, whose API is only available in a
+// single region of a partition, regardless of the provider region.
+var defaultGlobalServices = []*GlobalServiceConfig{
+	{
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+		Service:   "globalaccelerator",
+	},
+	{
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsEast1RegionID,
+		Service:   "route53",
+	},
+	{
+		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+		// This can likely be removed in the future.
+		Endpoint:  "https://api.route53.cn",
+		Partition: endpoints.AwsCnPartitionID,
+		Region:    endpoints.CnNorthwest1RegionID,
+		Service:   "route53",
+	},
+	{
+		Partition: endpoints.AwsUsGovPartitionID,
+		Region:    endpoints.UsGovWest1RegionID,
+		Service:   "route53",
+	},
+	{
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsEast1RegionID,
+		Service:   "shield",
+	},
+}
+
+// globalServicesForPartition returns the global service routing for the partition keyed
+// by endpoints key. The given overrides take precedence over defaultGlobalServices and,
+// within each, partition specific entries take precedence over those for all partitions.
+func globalServicesForPartition(partition string, overrides []*GlobalServiceConfig) map[string]*GlobalServiceConfig {
+	globalServices := make(map[string]*GlobalServiceConfig)
+
+	for _, configs := range [][]*GlobalServiceConfig{defaultGlobalServices, overrides} {
+		for _, matchPartition := range []string{"", partition} {
+			for _, config := range configs {
+				if config.Partition == matchPartition {
+					globalServices[config.Service] = config
+				}
+			}
 		}
 	}
 
-	return client, nil
+	return globalServices
+}
+
+// defaultRetryPolicies returns the retry policies applied to service clients
+// regardless of provider configuration, keyed by endpoints key.
+func defaultRetryPolicies() map[string]*retrypolicy.Policy {
+	return map[string]*retrypolicy.Policy{
+		"apigateway": {
+			RetryableErrors: []retrypolicy.RetryableError{
+				// Many operations can return an error such as:
+				//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+				// Handle them all globally for the service client.
+				{
+					Code:    apigateway.ErrCodeConflictException,
+					Message: "try again later",
+				},
+			},
+		},
+		"applicationautoscaling": {
+			RetryableErrors: []retrypolicy.RetryableError{
+				// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+				{
+					Code:       applicationautoscaling.ErrCodeFailedResourceAccessException,
+					Operations: []string{"Describe*", "List*"},
+				},
+			},
+		},
+		"appsync": {
+			RetryableErrors: []retrypolicy.RetryableError{
+				{
+					Code:       appsync.ErrCodeConcurrentModificationException,
+					Message:    "a GraphQL API creation is already in progress",
+					Operations: []string{"CreateGraphqlApi"},
+				},
+			},
+		},
+		"configservice": {
+			RetryableErrors: []retrypolicy.RetryableError{
+				// When calling Config Organization Rules API actions immediately
+				// after Organization creation, the API can randomly return the
+				// OrganizationAccessDeniedException error for a few minutes, even
+				// after succeeding a few requests.
+				{
+					Code:    configservice.ErrCodeOrganizationAccessDeniedException,
+					Message: "This action can be only made by AWS Organization's master account.",
+					Operations: []string{
+						"DeleteOrganizationConfigRule",
+						"DescribeOrganizationConfigRules",
+						"DescribeOrganizationConfigRuleStatuses",
+						"PutOrganizationConfigRule",
+					},
+					// We only want to retry briefly as the default max retry count would
+					// excessively retry when the error could be legitimate.
+					// We currently depend on the DefaultRetryer exponential backoff here.
+					// ~10 retries gives a fair backoff of a few seconds.
+					MaxRetries: 9,
+				},
+			},
+		},
+	}
 }
 
 func hasEc2Classic(platforms []string) bool {
@@ -0,0 +1,1197 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3outposts"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
+)
+
+// Service clients are created on first use rather than when the provider is configured.
+// Each method returns the client cached in the AWSClient, creating it from a service
+// session (see serviceSession) with any service specific customizations.
+
+func (client *AWSClient) accessanalyzerconn() *accessanalyzer.AccessAnalyzer {
+	return client.conn("accessanalyzer", func() interface{} {
+		return accessanalyzer.New(client.serviceSession("accessanalyzer"))
+	}).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) acmconn() *acm.ACM {
+	return client.conn("acm", func() interface{} {
+		return acm.New(client.serviceSession("acm"))
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) acmpcaconn() *acmpca.ACMPCA {
+	return client.conn("acmpca", func() interface{} {
+		return acmpca.New(client.serviceSession("acmpca"))
+	}).(*acmpca.ACMPCA)
+}
+
+func (client *AWSClient) amplifyconn() *amplify.Amplify {
+	return client.conn("amplify", func() interface{} {
+		return amplify.New(client.serviceSession("amplify"))
+	}).(*amplify.Amplify)
+}
+
+func (client *AWSClient) apigatewayconn() *apigateway.APIGateway {
+	return client.conn("apigateway", func() interface{} {
+		return apigateway.New(client.serviceSession("apigateway"))
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) apigatewayv2conn() *apigatewayv2.ApiGatewayV2 {
+	return client.conn("apigatewayv2", func() interface{} {
+		return apigatewayv2.New(client.serviceSession("apigateway"))
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) appautoscalingconn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.conn("applicationautoscaling", func() interface{} {
+		return applicationautoscaling.New(client.serviceSession("applicationautoscaling"))
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) applicationinsightsconn() *applicationinsights.ApplicationInsights {
+	return client.conn("applicationinsights", func() interface{} {
+		return applicationinsights.New(client.serviceSession("applicationinsights"))
+	}).(*applicationinsights.ApplicationInsights)
+}
+
+func (client *AWSClient) appmeshconn() *appmesh.AppMesh {
+	return client.conn("appmesh", func() interface{} {
+		return appmesh.New(client.serviceSession("appmesh"))
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) appstreamconn() *appstream.AppStream {
+	return client.conn("appstream", func() interface{} {
+		return appstream.New(client.serviceSession("appstream"))
+	}).(*appstream.AppStream)
+}
+
+func (client *AWSClient) appsyncconn() *appsync.AppSync {
+	return client.conn("appsync", func() interface{} {
+		return appsync.New(client.serviceSession("appsync"))
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) athenaconn() *athena.Athena {
+	return client.conn("athena", func() interface{} {
+		return athena.New(client.serviceSession("athena"))
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) autoscalingconn() *autoscaling.AutoScaling {
+	return client.conn("autoscaling", func() interface{} {
+		return autoscaling.New(client.serviceSession("autoscaling"))
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) autoscalingplansconn() *autoscalingplans.AutoScalingPlans {
+	return client.conn("autoscalingplans", func() interface{} {
+		return autoscalingplans.New(client.serviceSession("autoscalingplans"))
+	}).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) backupconn() *backup.Backup {
+	return client.conn("backup", func() interface{} {
+		return backup.New(client.serviceSession("backup"))
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) batchconn() *batch.Batch {
+	return client.conn("batch", func() interface{} {
+		return batch.New(client.serviceSession("batch"))
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) budgetconn() *budgets.Budgets {
+	return client.conn("budgets", func() interface{} {
+		return budgets.New(client.serviceSession("budgets"))
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) cfconn() *cloudformation.CloudFormation {
+	return client.conn("cloudformation", func() interface{} {
+		return cloudformation.New(client.serviceSession("cloudformation"))
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) cloud9conn() *cloud9.Cloud9 {
+	return client.conn("cloud9", func() interface{} {
+		return cloud9.New(client.serviceSession("cloud9"))
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) cloudfrontconn() *cloudfront.CloudFront {
+	return client.conn("cloudfront", func() interface{} {
+		return cloudfront.New(client.serviceSession("cloudfront"))
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) cloudhsmv2conn() *cloudhsmv2.CloudHSMV2 {
+	return client.conn("cloudhsm", func() interface{} {
+		return cloudhsmv2.New(client.serviceSession("cloudhsm"))
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) cloudsearchconn() *cloudsearch.CloudSearch {
+	return client.conn("cloudsearch", func() interface{} {
+		return cloudsearch.New(client.serviceSession("cloudsearch"))
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) cloudtrailconn() *cloudtrail.CloudTrail {
+	return client.conn("cloudtrail", func() interface{} {
+		return cloudtrail.New(client.serviceSession("cloudtrail"))
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) cloudwatchconn() *cloudwatch.CloudWatch {
+	return client.conn("cloudwatch", func() interface{} {
+		return cloudwatch.New(client.serviceSession("cloudwatch"))
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) cloudwatcheventsconn() *cloudwatchevents.CloudWatchEvents {
+	return client.conn("cloudwatchevents", func() interface{} {
+		return cloudwatchevents.New(client.serviceSession("cloudwatchevents"))
+	}).(*cloudwatchevents.CloudWatchEvents)
+}
+
+func (client *AWSClient) cloudwatchlogsconn() *cloudwatchlogs.CloudWatchLogs {
+	return client.conn("cloudwatchlogs", func() interface{} {
+		return cloudwatchlogs.New(client.serviceSession("cloudwatchlogs"))
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) codeartifactconn() *codeartifact.CodeArtifact {
+	return client.conn("codeartifact", func() interface{} {
+		return codeartifact.New(client.serviceSession("codeartifact"))
+	}).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) codebuildconn() *codebuild.CodeBuild {
+	return client.conn("codebuild", func() interface{} {
+		return codebuild.New(client.serviceSession("codebuild"))
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) codecommitconn() *codecommit.CodeCommit {
+	return client.conn("codecommit", func() interface{} {
+		return codecommit.New(client.serviceSession("codecommit"))
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) codedeployconn() *codedeploy.CodeDeploy {
+	return client.conn("codedeploy", func() interface{} {
+		return codedeploy.New(client.serviceSession("codedeploy"))
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) codepipelineconn() *codepipeline.CodePipeline {
+	return client.conn("codepipeline", func() interface{} {
+		return codepipeline.New(client.serviceSession("codepipeline"))
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) codestarconnectionsconn() *codestarconnections.CodeStarConnections {
+	return client.conn("codestarconnections", func() interface{} {
+		return codestarconnections.New(client.serviceSession("codestarconnections"))
+	}).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) codestarnotificationsconn() *codestarnotifications.CodeStarNotifications {
+	return client.conn("codestarnotifications", func() interface{} {
+		return codestarnotifications.New(client.serviceSession("codestarnotifications"))
+	}).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) cognitoconn() *cognitoidentity.CognitoIdentity {
+	return client.conn("cognitoidentity", func() interface{} {
+		return cognitoidentity.New(client.serviceSession("cognitoidentity"))
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) cognitoidpconn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.conn("cognitoidp", func() interface{} {
+		return cognitoidentityprovider.New(client.serviceSession("cognitoidp"))
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) configconn() *configservice.ConfigService {
+	return client.conn("configservice", func() interface{} {
+		return configservice.New(client.serviceSession("configservice"))
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) connectconn() *connect.Connect {
+	return client.conn("connect", func() interface{} {
+		return connect.New(client.serviceSession("connect"))
+	}).(*connect.Connect)
+}
+
+func (client *AWSClient) costandusagereportconn() *costandusagereportservice.CostandUsageReportService {
+	return client.conn("cur", func() interface{} {
+		return costandusagereportservice.New(client.serviceSession("cur"))
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) dataexchangeconn() *dataexchange.DataExchange {
+	return client.conn("dataexchange", func() interface{} {
+		return dataexchange.New(client.serviceSession("dataexchange"))
+	}).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) datapipelineconn() *datapipeline.DataPipeline {
+	return client.conn("datapipeline", func() interface{} {
+		return datapipeline.New(client.serviceSession("datapipeline"))
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) datasyncconn() *datasync.DataSync {
+	return client.conn("datasync", func() interface{} {
+		return datasync.New(client.serviceSession("datasync"))
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) daxconn() *dax.DAX {
+	return client.conn("dax", func() interface{} {
+		return dax.New(client.serviceSession("dax"))
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) devicefarmconn() *devicefarm.DeviceFarm {
+	return client.conn("devicefarm", func() interface{} {
+		return devicefarm.New(client.serviceSession("devicefarm"))
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) dlmconn() *dlm.DLM {
+	return client.conn("dlm", func() interface{} {
+		return dlm.New(client.serviceSession("dlm"))
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) dmsconn() *databasemigrationservice.DatabaseMigrationService {
+	return client.conn("dms", func() interface{} {
+		return databasemigrationservice.New(client.serviceSession("dms"))
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) docdbconn() *docdb.DocDB {
+	return client.conn("docdb", func() interface{} {
+		return docdb.New(client.serviceSession("docdb"))
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) dsconn() *directoryservice.DirectoryService {
+	return client.conn("ds", func() interface{} {
+		return directoryservice.New(client.serviceSession("ds"))
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) dxconn() *directconnect.DirectConnect {
+	return client.conn("directconnect", func() interface{} {
+		return directconnect.New(client.serviceSession("directconnect"))
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) dynamodbconn() *dynamodb.DynamoDB {
+	return client.conn("dynamodb", func() interface{} {
+		conn := dynamodb.New(client.serviceSession("dynamodb"))
+
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+				return
+			}
+			if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) ec2conn() *ec2.EC2 {
+	return client.conn("ec2", func() interface{} {
+		conn := ec2.New(client.serviceSession("ec2"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateClientVpnEndpoint" {
+				if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnConnection" {
+				if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnGateway" {
+				if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+				if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) ecrconn() *ecr.ECR {
+	return client.conn("ecr", func() interface{} {
+		return ecr.New(client.serviceSession("ecr"))
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ecrpublicconn() *ecrpublic.ECRPublic {
+	return client.conn("ecrpublic", func() interface{} {
+		return ecrpublic.New(client.serviceSession("ecrpublic"))
+	}).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ecsconn() *ecs.ECS {
+	return client.conn("ecs", func() interface{} {
+		return ecs.New(client.serviceSession("ecs"))
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) efsconn() *efs.EFS {
+	return client.conn("efs", func() interface{} {
+		return efs.New(client.serviceSession("efs"))
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) eksconn() *eks.EKS {
+	return client.conn("eks", func() interface{} {
+		return eks.New(client.serviceSession("eks"))
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) elasticacheconn() *elasticache.ElastiCache {
+	return client.conn("elasticache", func() interface{} {
+		return elasticache.New(client.serviceSession("elasticache"))
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) elasticbeanstalkconn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.conn("elasticbeanstalk", func() interface{} {
+		return elasticbeanstalk.New(client.serviceSession("elasticbeanstalk"))
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) elastictranscoderconn() *elastictranscoder.ElasticTranscoder {
+	return client.conn("elastictranscoder", func() interface{} {
+		return elastictranscoder.New(client.serviceSession("elastictranscoder"))
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) elbconn() *elb.ELB {
+	return client.conn("elb", func() interface{} {
+		return elb.New(client.serviceSession("elb"))
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) elbv2conn() *elbv2.ELBV2 {
+	return client.conn("elbv2", func() interface{} {
+		return elbv2.New(client.serviceSession("elb"))
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) emrconn() *emr.EMR {
+	return client.conn("emr", func() interface{} {
+		return emr.New(client.serviceSession("emr"))
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) esconn() *elasticsearch.ElasticsearchService {
+	return client.conn("es", func() interface{} {
+		return elasticsearch.New(client.serviceSession("es"))
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) firehoseconn() *firehose.Firehose {
+	return client.conn("firehose", func() interface{} {
+		return firehose.New(client.serviceSession("firehose"))
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) fmsconn() *fms.FMS {
+	return client.conn("fms", func() interface{} {
+		return fms.New(client.serviceSession("fms"))
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) forecastconn() *forecastservice.ForecastService {
+	return client.conn("forecast", func() interface{} {
+		return forecastservice.New(client.serviceSession("forecast"))
+	}).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) fsxconn() *fsx.FSx {
+	return client.conn("fsx", func() interface{} {
+		return fsx.New(client.serviceSession("fsx"))
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) gameliftconn() *gamelift.GameLift {
+	return client.conn("gamelift", func() interface{} {
+		return gamelift.New(client.serviceSession("gamelift"))
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) glacierconn() *glacier.Glacier {
+	return client.conn("glacier", func() interface{} {
+		return glacier.New(client.serviceSession("glacier"))
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) globalacceleratorconn() *globalaccelerator.GlobalAccelerator {
+	return client.conn("globalaccelerator", func() interface{} {
+		return globalaccelerator.New(client.serviceSession("globalaccelerator"))
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) glueconn() *glue.Glue {
+	return client.conn("glue", func() interface{} {
+		return glue.New(client.serviceSession("glue"))
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) guarddutyconn() *guardduty.GuardDuty {
+	return client.conn("guardduty", func() interface{} {
+		return guardduty.New(client.serviceSession("guardduty"))
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) greengrassconn() *greengrass.Greengrass {
+	return client.conn("greengrass", func() interface{} {
+		return greengrass.New(client.serviceSession("greengrass"))
+	}).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) iamconn() *iam.IAM {
+	return client.conn("iam", func() interface{} {
+		return iam.New(client.serviceSession("iam"))
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) identitystoreconn() *identitystore.IdentityStore {
+	return client.conn("identitystore", func() interface{} {
+		return identitystore.New(client.serviceSession("identitystore"))
+	}).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) imagebuilderconn() *imagebuilder.Imagebuilder {
+	return client.conn("imagebuilder", func() interface{} {
+		return imagebuilder.New(client.serviceSession("imagebuilder"))
+	}).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) inspectorconn() *inspector.Inspector {
+	return client.conn("inspector", func() interface{} {
+		return inspector.New(client.serviceSession("inspector"))
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) iotconn() *iot.IoT {
+	return client.conn("iot", func() interface{} {
+		return iot.New(client.serviceSession("iot"))
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) iotanalyticsconn() *iotanalytics.IoTAnalytics {
+	return client.conn("iotanalytics", func() interface{} {
+		return iotanalytics.New(client.serviceSession("iotanalytics"))
+	}).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) ioteventsconn() *iotevents.IoTEvents {
+	return client.conn("iotevents", func() interface{} {
+		return iotevents.New(client.serviceSession("iotevents"))
+	}).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) kafkaconn() *kafka.Kafka {
+	return client.conn("kafka", func() interface{} {
+		conn := kafka.New(client.serviceSession("kafka"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) kinesisanalyticsconn() *kinesisanalytics.KinesisAnalytics {
+	return client.conn("kinesisanalytics", func() interface{} {
+		return kinesisanalytics.New(client.serviceSession("kinesisanalytics"))
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) kinesisanalyticsv2conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.conn("kinesisanalyticsv2", func() interface{} {
+		return kinesisanalyticsv2.New(client.serviceSession("kinesisanalyticsv2"))
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) kinesisconn() *kinesis.Kinesis {
+	return client.conn("kinesis", func() interface{} {
+		conn := kinesis.New(client.serviceSession("kinesis"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateStream" {
+				if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+			if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+				if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) kinesisvideoconn() *kinesisvideo.KinesisVideo {
+	return client.conn("kinesisvideo", func() interface{} {
+		return kinesisvideo.New(client.serviceSession("kinesisvideo"))
+	}).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) kmsconn() *kms.KMS {
+	return client.conn("kms", func() interface{} {
+		return kms.New(client.serviceSession("kms"))
+	}).(*kms.KMS)
+}
+
+func (client *AWSClient) lakeformationconn() *lakeformation.LakeFormation {
+	return client.conn("lakeformation", func() interface{} {
+		return lakeformation.New(client.serviceSession("lakeformation"))
+	}).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) lambdaconn() *lambda.Lambda {
+	return client.conn("lambda", func() interface{} {
+		return lambda.New(client.serviceSession("lambda"))
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) lexmodelconn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.conn("lexmodels", func() interface{} {
+		return lexmodelbuildingservice.New(client.serviceSession("lexmodels"))
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) licensemanagerconn() *licensemanager.LicenseManager {
+	return client.conn("licensemanager", func() interface{} {
+		return licensemanager.New(client.serviceSession("licensemanager"))
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) lightsailconn() *lightsail.Lightsail {
+	return client.conn("lightsail", func() interface{} {
+		return lightsail.New(client.serviceSession("lightsail"))
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) macieconn() *macie.Macie {
+	return client.conn("macie", func() interface{} {
+		return macie.New(client.serviceSession("macie"))
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) macie2conn() *macie2.Macie2 {
+	return client.conn("macie2", func() interface{} {
+		return macie2.New(client.serviceSession("macie2"))
+	}).(*macie2.Macie2)
+}
+
+func (client *AWSClient) managedblockchainconn() *managedblockchain.ManagedBlockchain {
+	return client.conn("managedblockchain", func() interface{} {
+		return managedblockchain.New(client.serviceSession("managedblockchain"))
+	}).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) marketplacecatalogconn() *marketplacecatalog.MarketplaceCatalog {
+	return client.conn("marketplacecatalog", func() interface{} {
+		return marketplacecatalog.New(client.serviceSession("marketplacecatalog"))
+	}).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) mediaconnectconn() *mediaconnect.MediaConnect {
+	return client.conn("mediaconnect", func() interface{} {
+		return mediaconnect.New(client.serviceSession("mediaconnect"))
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) mediaconvertconn() *mediaconvert.MediaConvert {
+	return client.conn("mediaconvert", func() interface{} {
+		return mediaconvert.New(client.serviceSession("mediaconvert"))
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) medialiveconn() *medialive.MediaLive {
+	return client.conn("medialive", func() interface{} {
+		return medialive.New(client.serviceSession("medialive"))
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) mediapackageconn() *mediapackage.MediaPackage {
+	return client.conn("mediapackage", func() interface{} {
+		return mediapackage.New(client.serviceSession("mediapackage"))
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) mediastoreconn() *mediastore.MediaStore {
+	return client.conn("mediastore", func() interface{} {
+		return mediastore.New(client.serviceSession("mediastore"))
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) mediastoredataconn() *mediastoredata.MediaStoreData {
+	return client.conn("mediastoredata", func() interface{} {
+		return mediastoredata.New(client.serviceSession("mediastoredata"))
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) mqconn() *mq.MQ {
+	return client.conn("mq", func() interface{} {
+		return mq.New(client.serviceSession("mq"))
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) mwaaconn() *mwaa.MWAA {
+	return client.conn("mwaa", func() interface{} {
+		return mwaa.New(client.serviceSession("mwaa"))
+	}).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) neptuneconn() *neptune.Neptune {
+	return client.conn("neptune", func() interface{} {
+		return neptune.New(client.serviceSession("neptune"))
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) networkfirewallconn() *networkfirewall.NetworkFirewall {
+	return client.conn("networkfirewall", func() interface{} {
+		return networkfirewall.New(client.serviceSession("networkfirewall"))
+	}).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) networkmanagerconn() *networkmanager.NetworkManager {
+	return client.conn("networkmanager", func() interface{} {
+		return networkmanager.New(client.serviceSession("networkmanager"))
+	}).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) opsworksconn() *opsworks.OpsWorks {
+	return client.conn("opsworks", func() interface{} {
+		return opsworks.New(client.serviceSession("opsworks"))
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) organizationsconn() *organizations.Organizations {
+	return client.conn("organizations", func() interface{} {
+		conn := organizations.New(client.serviceSession("organizations"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Retry on the following error:
+			// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+			if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) outpostsconn() *outposts.Outposts {
+	return client.conn("outposts", func() interface{} {
+		return outposts.New(client.serviceSession("outposts"))
+	}).(*outposts.Outposts)
+}
+
+func (client *AWSClient) personalizeconn() *personalize.Personalize {
+	return client.conn("personalize", func() interface{} {
+		return personalize.New(client.serviceSession("personalize"))
+	}).(*personalize.Personalize)
+}
+
+func (client *AWSClient) pinpointconn() *pinpoint.Pinpoint {
+	return client.conn("pinpoint", func() interface{} {
+		return pinpoint.New(client.serviceSession("pinpoint"))
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) pricingconn() *pricing.Pricing {
+	return client.conn("pricing", func() interface{} {
+		return pricing.New(client.serviceSession("pricing"))
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) qldbconn() *qldb.QLDB {
+	return client.conn("qldb", func() interface{} {
+		return qldb.New(client.serviceSession("qldb"))
+	}).(*qldb.QLDB)
+}
+
+func (client *AWSClient) quicksightconn() *quicksight.QuickSight {
+	return client.conn("quicksight", func() interface{} {
+		return quicksight.New(client.serviceSession("quicksight"))
+	}).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) r53conn() *route53.Route53 {
+	return client.conn("route53", func() interface{} {
+		return route53.New(client.serviceSession("route53"))
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) ramconn() *ram.RAM {
+	return client.conn("ram", func() interface{} {
+		return ram.New(client.serviceSession("ram"))
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) rdsconn() *rds.RDS {
+	return client.conn("rds", func() interface{} {
+		return rds.New(client.serviceSession("rds"))
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) redshiftconn() *redshift.Redshift {
+	return client.conn("redshift", func() interface{} {
+		return redshift.New(client.serviceSession("redshift"))
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) resourcegroupsconn() *resourcegroups.ResourceGroups {
+	return client.conn("resourcegroups", func() interface{} {
+		return resourcegroups.New(client.serviceSession("resourcegroups"))
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) resourcegroupstaggingapiconn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.conn("resourcegroupstaggingapi", func() interface{} {
+		return resourcegroupstaggingapi.New(client.serviceSession("resourcegroupstaggingapi"))
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) route53domainsconn() *route53domains.Route53Domains {
+	return client.conn("route53domains", func() interface{} {
+		return route53domains.New(client.serviceSession("route53domains"))
+	}).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) route53resolverconn() *route53resolver.Route53Resolver {
+	return client.conn("route53resolver", func() interface{} {
+		return route53resolver.New(client.serviceSession("route53resolver"))
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) s3conn() *s3.S3 {
+	return client.conn("s3", func() interface{} {
+		return s3.New(client.serviceSession("s3", &aws.Config{
+			S3ForcePathStyle: aws.Bool(client.s3ForcePathStyle),
+		}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3connUriCleaningDisabled() *s3.S3 {
+	return client.conn("s3UriCleaningDisabled", func() interface{} {
+		return s3.New(client.serviceSession("s3", &aws.Config{
+			DisableRestProtocolURICleaning: aws.Bool(true),
+			S3ForcePathStyle:               aws.Bool(client.s3ForcePathStyle),
+		}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3controlconn() *s3control.S3Control {
+	return client.conn("s3control", func() interface{} {
+		return s3control.New(client.serviceSession("s3control"))
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) s3outpostsconn() *s3outposts.S3Outposts {
+	return client.conn("s3outposts", func() interface{} {
+		return s3outposts.New(client.serviceSession("s3outposts"))
+	}).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) sagemakerconn() *sagemaker.SageMaker {
+	return client.conn("sagemaker", func() interface{} {
+		return sagemaker.New(client.serviceSession("sagemaker"))
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) scconn() *servicecatalog.ServiceCatalog {
+	return client.conn("servicecatalog", func() interface{} {
+		return servicecatalog.New(client.serviceSession("servicecatalog"))
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) sdconn() *servicediscovery.ServiceDiscovery {
+	return client.conn("servicediscovery", func() interface{} {
+		return servicediscovery.New(client.serviceSession("servicediscovery"))
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) secretsmanagerconn() *secretsmanager.SecretsManager {
+	return client.conn("secretsmanager", func() interface{} {
+		return secretsmanager.New(client.serviceSession("secretsmanager"))
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) securityhubconn() *securityhub.SecurityHub {
+	return client.conn("securityhub", func() interface{} {
+		return securityhub.New(client.serviceSession("securityhub"))
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) serverlessapplicationrepositoryconn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.conn("serverlessrepo", func() interface{} {
+		return serverlessapplicationrepository.New(client.serviceSession("serverlessrepo"))
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) servicequotasconn() *servicequotas.ServiceQuotas {
+	return client.conn("servicequotas", func() interface{} {
+		return servicequotas.New(client.serviceSession("servicequotas"))
+	}).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) sesconn() *ses.SES {
+	return client.conn("ses", func() interface{} {
+		return ses.New(client.serviceSession("ses"))
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) sfnconn() *sfn.SFN {
+	return client.conn("stepfunctions", func() interface{} {
+		return sfn.New(client.serviceSession("stepfunctions"))
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) shieldconn() *shield.Shield {
+	return client.conn("shield", func() interface{} {
+		return shield.New(client.serviceSession("shield"))
+	}).(*shield.Shield)
+}
+
+func (client *AWSClient) signerconn() *signer.Signer {
+	return client.conn("signer", func() interface{} {
+		return signer.New(client.serviceSession("signer"))
+	}).(*signer.Signer)
+}
+
+func (client *AWSClient) simpledbconn() *simpledb.SimpleDB {
+	return client.conn("sdb", func() interface{} {
+		return simpledb.New(client.serviceSession("sdb"))
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) snsconn() *sns.SNS {
+	return client.conn("sns", func() interface{} {
+		return sns.New(client.serviceSession("sns"))
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) sqsconn() *sqs.SQS {
+	return client.conn("sqs", func() interface{} {
+		return sqs.New(client.serviceSession("sqs"))
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) ssmconn() *ssm.SSM {
+	return client.conn("ssm", func() interface{} {
+		return ssm.New(client.serviceSession("ssm"))
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) ssoadminconn() *ssoadmin.SSOAdmin {
+	return client.conn("ssoadmin", func() interface{} {
+		return ssoadmin.New(client.serviceSession("ssoadmin"))
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) storagegatewayconn() *storagegateway.StorageGateway {
+	return client.conn("storagegateway", func() interface{} {
+		conn := storagegateway.New(client.serviceSession("storagegateway"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) stsconn() *sts.STS {
+	return client.conn("sts", func() interface{} {
+		return sts.New(client.serviceSession("sts"))
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) swfconn() *swf.SWF {
+	return client.conn("swf", func() interface{} {
+		return swf.New(client.serviceSession("swf"))
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) syntheticsconn() *synthetics.Synthetics {
+	return client.conn("synthetics", func() interface{} {
+		return synthetics.New(client.serviceSession("synthetics"))
+	}).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) timestreamwriteconn() *timestreamwrite.TimestreamWrite {
+	return client.conn("timestreamwrite", func() interface{} {
+		return timestreamwrite.New(client.serviceSession("timestreamwrite"))
+	}).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) transferconn() *transfer.Transfer {
+	return client.conn("transfer", func() interface{} {
+		return transfer.New(client.serviceSession("transfer"))
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) wafconn() *waf.WAF {
+	return client.conn("waf", func() interface{} {
+		return waf.New(client.serviceSession("waf"))
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) wafregionalconn() *wafregional.WAFRegional {
+	return client.conn("wafregional", func() interface{} {
+		return wafregional.New(client.serviceSession("wafregional"))
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) wafv2conn() *wafv2.WAFV2 {
+	return client.conn("wafv2", func() interface{} {
+		conn := wafv2.New(client.serviceSession("wafv2"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+				r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+				// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+				if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+				if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) worklinkconn() *worklink.WorkLink {
+	return client.conn("worklink", func() interface{} {
+		return worklink.New(client.serviceSession("worklink"))
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) workmailconn() *workmail.WorkMail {
+	return client.conn("workmail", func() interface{} {
+		return workmail.New(client.serviceSession("workmail"))
+	}).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) workspacesconn() *workspaces.WorkSpaces {
+	return client.conn("workspaces", func() interface{} {
+		return workspaces.New(client.serviceSession("workspaces"))
+	}).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) xrayconn() *xray.XRay {
+	return client.conn("xray", func() interface{} {
+		return xray.New(client.serviceSession("xray"))
+	}).(*xray.XRay)
+}
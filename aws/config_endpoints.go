@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// fipsEndpointResolver resolves FIPS 140-2 validated endpoints for any service.
+//
+// The AWS SDK endpoint model lists FIPS endpoints for some services as pseudo
+// regions, e.g. fips-us-gov-west-1 or us-east-1-fips, which are preferred when
+// present. Otherwise the standard endpoint hostname is rewritten to the
+// SERVICE-fips.REGION.DNS_SUFFIX naming scheme used by FIPS endpoints.
+type fipsEndpointResolver struct {
+	resolver endpoints.Resolver
+}
+
+func (r fipsEndpointResolver) EndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	strictOpts := make([]func(*endpoints.Options), 0, len(opts)+1)
+	strictOpts = append(strictOpts, opts...)
+	strictOpts = append(strictOpts, func(o *endpoints.Options) {
+		o.StrictMatching = true
+	})
+
+	for _, fipsRegion := range []string{"fips-" + region, region + "-fips"} {
+		if endpoint, err := r.resolver.EndpointFor(service, fipsRegion, strictOpts...); err == nil {
+			return endpoint, nil
+		}
+	}
+
+	endpoint, err := r.resolver.EndpointFor(service, region, opts...)
+
+	if err != nil {
+		return endpoint, err
+	}
+
+	endpoint.URL = fipsEndpointURL(endpoint.URL)
+
+	return endpoint, nil
+}
+
+// fipsEndpointURL returns the FIPS variant of an endpoint URL by appending
+// -fips to the first hostname label, e.g. https://ec2.us-east-1.amazonaws.com
+// becomes https://ec2-fips.us-east-1.amazonaws.com.
+func fipsEndpointURL(endpointURL string) string {
+	u, err := url.Parse(endpointURL)
+
+	if err != nil || u.Host == "" {
+		return endpointURL
+	}
+
+	labels := strings.SplitN(u.Host, ".", 2)
+
+	if strings.HasSuffix(labels[0], "-fips") || strings.HasPrefix(labels[0], "fips") {
+		return endpointURL
+	}
+
+	labels[0] += "-fips"
+	u.Host = strings.Join(labels, ".")
+
+	return u.String()
+}
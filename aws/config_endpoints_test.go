@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestFipsEndpointURL(t *testing.T) {
+	testCases := []struct {
+		URL      string
+		Expected string
+	}{
+		{
+			URL:      "https://ec2.us-east-1.amazonaws.com",
+			Expected: "https://ec2-fips.us-east-1.amazonaws.com",
+		},
+		{
+			URL:      "https://iam.amazonaws.com",
+			Expected: "https://iam-fips.amazonaws.com",
+		},
+		{
+			URL:      "https://sts-fips.us-east-2.amazonaws.com",
+			Expected: "https://sts-fips.us-east-2.amazonaws.com",
+		},
+		{
+			URL:      "https://fips.transfer.us-east-1.amazonaws.com",
+			Expected: "https://fips.transfer.us-east-1.amazonaws.com",
+		},
+		{
+			URL:      "not a url",
+			Expected: "not a url",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.URL, func(t *testing.T) {
+			if got := fipsEndpointURL(testCase.URL); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFipsEndpointResolver(t *testing.T) {
+	resolver := fipsEndpointResolver{
+		resolver: endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+			var o endpoints.Options
+
+			for _, opt := range opts {
+				opt(&o)
+			}
+
+			switch {
+			case service == "kms" && region == "fips-us-gov-west-1":
+				return endpoints.ResolvedEndpoint{URL: "https://kms-fips.us-gov-west-1.amazonaws.com", SigningRegion: "us-gov-west-1"}, nil
+			case o.StrictMatching:
+				return endpoints.ResolvedEndpoint{}, fmt.Errorf("unknown region %s", region)
+			}
+
+			return endpoints.ResolvedEndpoint{URL: fmt.Sprintf("https://%s.%s.amazonaws.com", service, region), SigningRegion: region}, nil
+		}),
+	}
+
+	testCases := []struct {
+		Service               string
+		Region                string
+		ExpectedURL           string
+		ExpectedSigningRegion string
+	}{
+		{
+			Service:               "kms",
+			Region:                "us-gov-west-1",
+			ExpectedURL:           "https://kms-fips.us-gov-west-1.amazonaws.com",
+			ExpectedSigningRegion: "us-gov-west-1",
+		},
+		{
+			Service:               "ec2",
+			Region:                "us-gov-east-1",
+			ExpectedURL:           "https://ec2-fips.us-gov-east-1.amazonaws.com",
+			ExpectedSigningRegion: "us-gov-east-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Service, func(t *testing.T) {
+			endpoint, err := resolver.EndpointFor(testCase.Service, testCase.Region)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if endpoint.URL != testCase.ExpectedURL {
+				t.Errorf("got URL %s, expected %s", endpoint.URL, testCase.ExpectedURL)
+			}
+
+			if endpoint.SigningRegion != testCase.ExpectedSigningRegion {
+				t.Errorf("got signing region %s, expected %s", endpoint.SigningRegion, testCase.ExpectedSigningRegion)
+			}
+		})
+	}
+}
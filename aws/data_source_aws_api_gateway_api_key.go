@@ -49,7 +49,7 @@ func dataSourceAwsApiGatewayApiKey() *schema.Resource {
 }
 
 func dataSourceAwsApiGatewayApiKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayconn
+	conn := meta.(*AWSClient).apigatewayconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	apiKey, err := conn.GetApiKey(&apigateway.GetApiKeyInput{
@@ -48,7 +48,7 @@ func dataSourceAwsAutoscalingGroups() *schema.Resource {
 }
 
 func dataSourceAwsAutoscalingGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	log.Printf("[DEBUG] Reading Autoscaling Groups.")
 
@@ -36,7 +36,7 @@ func dataSourceAwsBackupPlan() *schema.Resource {
 }
 
 func dataSourceAwsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	id := d.Get("plan_id").(string)
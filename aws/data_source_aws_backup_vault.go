@@ -36,7 +36,7 @@ func dataSourceAwsBackupVault() *schema.Resource {
 }
 
 func dataSourceAwsBackupVaultRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	name := d.Get("name").(string)
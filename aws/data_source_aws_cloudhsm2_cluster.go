@@ -74,7 +74,7 @@ func dataSourceCloudHsmV2Cluster() *schema.Resource {
 }
 
 func dataSourceCloudHsmV2ClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudhsmv2conn
+	conn := meta.(*AWSClient).cloudhsmv2conn()
 
 	clusterId := d.Get("cluster_id").(string)
 	filters := []*string{&clusterId}
@@ -32,7 +32,7 @@ func dataSourceAwsCognitoUserPools() *schema.Resource {
 }
 
 func dataSourceAwsCognitoUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 	name := d.Get("name").(string)
 	var ids []string
 	var arns []string
@@ -46,7 +46,7 @@ func dataSourceAwsCustomerGateway() *schema.Resource {
 }
 
 func dataSourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	input := ec2.DescribeCustomerGatewaysInput{}
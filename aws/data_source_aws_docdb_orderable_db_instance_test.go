@@ -60,7 +60,7 @@ func TestAccAWSDocdbOrderableDbInstanceDataSource_preferred(t *testing.T) {
 }
 
 func testAccPreCheckAWSDocdbOrderableDbInstance(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn()
 
 	input := &docdb.DescribeOrderableDBInstanceOptionsInput{
 		Engine: aws.String("docdb"),
@@ -16,7 +16,7 @@ func dataSourceAwsEbsVolumes() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"filter": ec2CustomFiltersSchema(),
 
-			"tags": tagsSchema(),
+			"tags": tagsSchemaComputed(),
 
 			"ids": {
 				Type:     schema.TypeSet,
@@ -29,7 +29,7 @@ func dataSourceAwsEbsVolumes() *schema.Resource {
 }
 
 func dataSourceAwsEbsVolumesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVolumesInput{}
 
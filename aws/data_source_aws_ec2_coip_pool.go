@@ -42,7 +42,7 @@ func dataSourceAwsEc2CoipPool() *schema.Resource {
 }
 
 func dataSourceAwsEc2CoipPoolRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeCoipPoolsInput{}
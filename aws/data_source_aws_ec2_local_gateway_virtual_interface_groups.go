@@ -30,7 +30,7 @@ func dataSourceAwsEc2LocalGatewayVirtualInterfaceGroups() *schema.Resource {
 }
 
 func dataSourceAwsEc2LocalGatewayVirtualInterfaceGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput{}
 
@@ -48,7 +48,7 @@ func TestAccAwsEc2SpotPriceDataSource_Filter(t *testing.T) {
 }
 
 func testAccPreCheckAwsEc2SpotPrice(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeSpotPriceHistoryInput{
 		MaxResults: aws.Int64(5),
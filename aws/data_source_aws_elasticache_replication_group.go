@@ -75,7 +75,7 @@ func dataSourceAwsElasticacheReplicationGroup() *schema.Resource {
 }
 
 func dataSourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	groupID := d.Get("replication_group_id").(string)
 
@@ -195,7 +195,7 @@ func dataSourceAwsElb() *schema.Resource {
 }
 
 func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	lbName := d.Get("name").(string)
@@ -223,5 +223,5 @@ func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("arn", arn.String())
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, resp.LoadBalancerDescriptions[0], ignoreTagsConfig)
+	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn(), elbconn, resp.LoadBalancerDescriptions[0], ignoreTagsConfig)
 }
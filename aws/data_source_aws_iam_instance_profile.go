@@ -47,7 +47,7 @@ func dataSourceAwsIAMInstanceProfile() *schema.Resource {
 }
 
 func dataSourceAwsIAMInstanceProfileRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	name := d.Get("name").(string)
 
@@ -31,6 +31,14 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"override_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
 			"policy_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -39,6 +47,14 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -113,6 +129,36 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	// merge in source_policy_documents in order, which must not contain duplicate sids
+	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
+		sidMap := make(map[string]struct{})
+
+		for _, stmt := range mergedDoc.Statements {
+			if stmt != nil && len(stmt.Sid) > 0 {
+				sidMap[stmt.Sid] = struct{}{}
+			}
+		}
+
+		for i, sourceJSON := range v.([]interface{}) {
+			sourceDoc, err := dataSourceAwsIamPolicyDocumentUnmarshal(sourceJSON)
+			if err != nil {
+				return fmt.Errorf("error reading source_policy_documents (%d): %w", i, err)
+			}
+
+			for _, stmt := range sourceDoc.Statements {
+				if stmt == nil || len(stmt.Sid) == 0 {
+					continue
+				}
+				if _, ok := sidMap[stmt.Sid]; ok {
+					return fmt.Errorf("Found duplicate sid (%s) in source_policy_documents (%d). Either remove the sid or ensure the sid is unique across all source policy documents.", stmt.Sid, i)
+				}
+				sidMap[stmt.Sid] = struct{}{}
+			}
+
+			mergedDoc.Merge(sourceDoc)
+		}
+	}
+
 	// process the current document
 	doc := &IAMPolicyDoc{
 		Version: d.Get("version").(string),
@@ -213,6 +259,18 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		mergedDoc.Merge(overrideDoc)
 	}
 
+	// merge in override_policy_documents in order, later documents overriding earlier ones
+	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]interface{})) > 0 {
+		for i, overrideJSON := range v.([]interface{}) {
+			overrideDoc, err := dataSourceAwsIamPolicyDocumentUnmarshal(overrideJSON)
+			if err != nil {
+				return fmt.Errorf("error reading override_policy_documents (%d): %w", i, err)
+			}
+
+			mergedDoc.Merge(overrideDoc)
+		}
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
@@ -226,6 +284,20 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// dataSourceAwsIamPolicyDocumentUnmarshal returns the policy document of a policy documents list element.
+// Empty elements are an empty policy document.
+func dataSourceAwsIamPolicyDocumentUnmarshal(v interface{}) (*IAMPolicyDoc, error) {
+	doc := &IAMPolicyDoc{}
+
+	if s, ok := v.(string); ok && s != "" {
+		if err := json.Unmarshal([]byte(s), doc); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
 func dataSourceAwsIamPolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
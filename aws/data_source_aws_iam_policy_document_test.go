@@ -91,6 +91,53 @@ func TestAccAWSDataSourceIAMPolicyDocument_override(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_sourceList(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSourceListConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_source_list", "json",
+						testAccAWSIAMPolicyDocumentSourceListExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_sourceListConflicting(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentSourceListConflictingConfig,
+				ExpectError: regexp.MustCompile(`Found duplicate sid \(SourceListTest\) in source_policy_documents \(1\)`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_overrideList(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentOverrideListConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test_override_list", "json",
+						testAccAWSIAMPolicyDocumentOverrideListExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDataSourceIAMPolicyDocument_noStatementMerge(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -671,6 +718,169 @@ var testAccAWSIAMPolicyDocumentOverrideExpectedJSON = `{
   ]
 }`
 
+var testAccAWSIAMPolicyDocumentSourceListConfig = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid       = ""
+    effect    = "Allow"
+    actions   = ["foo:ActionOne"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "ValidSid"
+    effect    = "Allow"
+    actions   = ["bar:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid       = "ValidSid2"
+    effect    = "Deny"
+    actions   = ["foo:ActionTwo"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_list" {
+  version = "2012-10-17"
+
+  source_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json,
+  ]
+
+  statement {
+    sid       = "ValidSid2"
+    effect    = "Allow"
+    actions   = ["bar:ActionTwo"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentSourceListExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "foo:ActionOne",
+      "Resource": "*"
+    },
+    {
+      "Sid": "ValidSid",
+      "Effect": "Allow",
+      "Action": "bar:ActionOne",
+      "Resource": "*"
+    },
+    {
+      "Sid": "ValidSid2",
+      "Effect": "Allow",
+      "Action": "bar:ActionTwo",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentSourceListConflictingConfig = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid       = "SourceListTest"
+    actions   = ["foo:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid       = "SourceListTest"
+    actions   = ["foo:ActionTwo"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_source_list_conflicting" {
+  source_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json,
+  ]
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverrideListConfig = `
+data "aws_iam_policy_document" "policy_a" {
+  statement {
+    sid       = ""
+    effect    = "Allow"
+    actions   = ["foo:ActionOne"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "OverridePlaceholder"
+    effect    = "Allow"
+    actions   = ["bar:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_b" {
+  statement {
+    sid       = "OverridePlaceholder"
+    effect    = "Deny"
+    actions   = ["foo:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "policy_c" {
+  statement {
+    sid       = "OverridePlaceholder"
+    effect    = "Deny"
+    actions   = ["bar:ActionOne"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test_override_list" {
+  version = "2012-10-17"
+
+  override_policy_documents = [
+    data.aws_iam_policy_document.policy_a.json,
+    data.aws_iam_policy_document.policy_b.json,
+    data.aws_iam_policy_document.policy_c.json,
+  ]
+
+  statement {
+    sid       = "OverridePlaceholder"
+    effect    = "Allow"
+    actions   = ["baz:ActionOne"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverrideListExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "OverridePlaceholder",
+      "Effect": "Deny",
+      "Action": "bar:ActionOne",
+      "Resource": "*"
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "foo:ActionOne",
+      "Resource": "*"
+    }
+  ]
+}`
+
 var testAccAWSIAMPolicyDocumentNoStatementMergeConfig = `
 data "aws_iam_policy_document" "source" {
   statement {
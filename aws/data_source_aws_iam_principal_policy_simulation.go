@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+func dataSourceAwsIAMPrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMPrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"additional_policies_json": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"additional_policies_json", "policy_source_arn"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyDocument(iamPolicyTypeIdentity),
+				},
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyDocument(iamPolicyTypeManaged),
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"additional_policies_json", "policy_source_arn"},
+				ValidateFunc: validateArn,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIAMPolicyDocument(iamPolicyTypeResource),
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMPrincipalPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn()
+
+	var results []*iam.EvaluationResult
+
+	pageFn := func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.EvaluationResults...)
+
+		return !lastPage
+	}
+
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames: expandStringSet(d.Get("action_names").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("additional_policies_json"); ok && len(v.([]interface{})) > 0 {
+		input.PolicyInputList = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("caller_arn"); ok {
+		input.CallerArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+		input.ContextEntries = expandIamContextEntries(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && len(v.([]interface{})) > 0 {
+		input.PermissionsBoundaryPolicyInputList = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceArns = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_owner_account_id"); ok {
+		input.ResourceOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_policy_json"); ok {
+		input.ResourcePolicy = aws.String(v.(string))
+	}
+
+	// With a principal, the principal's policies are simulated along with any additional policies.
+	// Otherwise only the additional policies are simulated.
+	if v, ok := d.GetOk("policy_source_arn"); ok {
+		input.PolicySourceArn = aws.String(v.(string))
+
+		if err := conn.SimulatePrincipalPolicyPages(input, pageFn); err != nil {
+			return fmt.Errorf("error simulating IAM Principal (%s) policies: %w", v.(string), err)
+		}
+	} else {
+		customInput := &iam.SimulateCustomPolicyInput{
+			ActionNames:                        input.ActionNames,
+			CallerArn:                          input.CallerArn,
+			ContextEntries:                     input.ContextEntries,
+			PermissionsBoundaryPolicyInputList: input.PermissionsBoundaryPolicyInputList,
+			PolicyInputList:                    input.PolicyInputList,
+			ResourceArns:                       input.ResourceArns,
+			ResourceOwner:                      input.ResourceOwner,
+			ResourcePolicy:                     input.ResourcePolicy,
+		}
+
+		if err := conn.SimulateCustomPolicyPages(customInput, pageFn); err != nil {
+			return fmt.Errorf("error simulating IAM custom policies: %w", err)
+		}
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(input.String())))
+
+	tfList := flattenIamEvaluationResults(results)
+	allAllowed := true
+
+	for _, tfMapRaw := range tfList {
+		if !tfMapRaw.(map[string]interface{})["allowed"].(bool) {
+			allAllowed = false
+			break
+		}
+	}
+
+	d.Set("all_allowed", allAllowed)
+
+	if err := d.Set("results", tfList); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	return nil
+}
+
+func expandIamContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iam.ContextEntry{
+			ContextKeyName: aws.String(tfMap["key"].(string)),
+			ContextKeyType: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ContextKeyValues = expandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenIamEvaluationResults returns one result for each action, or for each action and resource
+// if the simulation returned resource specific results.
+func flattenIamEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if len(apiObject.ResourceSpecificResults) == 0 {
+			tfList = append(tfList, map[string]interface{}{
+				"action_name":          aws.StringValue(apiObject.EvalActionName),
+				"allowed":              aws.StringValue(apiObject.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+				"decision":             aws.StringValue(apiObject.EvalDecision),
+				"decision_details":     pointersMapToStringList(apiObject.EvalDecisionDetails),
+				"matched_statements":   flattenIamStatements(apiObject.MatchedStatements),
+				"missing_context_keys": flattenStringSet(apiObject.MissingContextValues),
+				"resource_arn":         aws.StringValue(apiObject.EvalResourceName),
+			})
+
+			continue
+		}
+
+		for _, resourceResult := range apiObject.ResourceSpecificResults {
+			if resourceResult == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"action_name":          aws.StringValue(apiObject.EvalActionName),
+				"allowed":              aws.StringValue(resourceResult.EvalResourceDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+				"decision":             aws.StringValue(resourceResult.EvalResourceDecision),
+				"decision_details":     pointersMapToStringList(resourceResult.EvalDecisionDetails),
+				"matched_statements":   flattenIamStatements(resourceResult.MatchedStatements),
+				"missing_context_keys": flattenStringSet(resourceResult.MissingContextValues),
+				"resource_arn":         aws.StringValue(resourceResult.EvalResourceName),
+			})
+		}
+	}
+
+	return tfList
+}
+
+func flattenIamStatements(apiObjects []*iam.Statement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source_policy_id":   aws.StringValue(apiObject.SourcePolicyId),
+			"source_policy_type": aws.StringValue(apiObject.SourcePolicyType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPrincipalPolicySimulation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceIAMPrincipalPolicySimulationConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.*", map[string]string{
+						"action_name":          "s3:GetObject",
+						"allowed":              "true",
+						"decision":             "allowed",
+						"matched_statements.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.*", map[string]string{
+						"action_name":          "s3:PutObject",
+						"allowed":              "false",
+						"decision":             "implicitDeny",
+						"matched_statements.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPrincipalPolicySimulation_customPolicy(t *testing.T) {
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceIAMPrincipalPolicySimulationConfigCustomPolicy(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.missing_context_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceIAMPrincipalPolicySimulationConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "ec2.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::%[1]s/*"
+    }]
+  })
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names      = ["s3:GetObject", "s3:PutObject"]
+  policy_source_arn = aws_iam_role.test.arn
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/key"]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
+
+func testAccAWSDataSourceIAMPrincipalPolicySimulationConfigCustomPolicy() string {
+	return `
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names = ["s3:GetObject"]
+
+  additional_policies_json = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceVpc" = "vpc-12345678"
+        }
+      }
+    }]
+  })]
+
+  context {
+    key    = "aws:SourceVpc"
+    type   = "string"
+    values = ["vpc-12345678"]
+  }
+}
+`
+}
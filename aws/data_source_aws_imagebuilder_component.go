@@ -74,7 +74,7 @@ func dataSourceAwsImageBuilderComponent() *schema.Resource {
 }
 
 func dataSourceAwsImageBuilderComponentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	input := &imagebuilder.GetComponentInput{}
@@ -125,7 +125,7 @@ func dataSourceAwsImageBuilderImageRecipe() *schema.Resource {
 }
 
 func dataSourceAwsImageBuilderImageRecipeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	input := &imagebuilder.GetImageRecipeInput{}
@@ -25,7 +25,7 @@ func dataSourceAwsInspectorRulesPackages() *schema.Resource {
 }
 
 func dataSourceAwsInspectorRulesPackagesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	log.Printf("[DEBUG] Reading Rules Packages.")
 
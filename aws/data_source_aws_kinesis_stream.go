@@ -64,7 +64,7 @@ func dataSourceAwsKinesisStream() *schema.Resource {
 }
 
 func dataSourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	sn := d.Get("name").(string)
@@ -34,7 +34,7 @@ func dataSourceAwsLakeFormationResource() *schema.Resource {
 }
 
 func dataSourceAwsLakeFormationResourceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.DescribeResourceInput{}
 
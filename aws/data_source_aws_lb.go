@@ -150,7 +150,7 @@ func dataSourceAwsLb() *schema.Resource {
 }
 
 func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 	lbArn := d.Get("arn").(string)
 	lbName := d.Get("name").(string)
 
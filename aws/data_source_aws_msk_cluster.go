@@ -54,7 +54,7 @@ func dataSourceAwsMskCluster() *schema.Resource {
 }
 
 func dataSourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	listClustersInput := &kafka.ListClustersInput{
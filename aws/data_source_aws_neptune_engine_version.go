@@ -83,7 +83,7 @@ func dataSourceAwsNeptuneEngineVersion() *schema.Resource {
 }
 
 func dataSourceAwsNeptuneEngineVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	input := &neptune.DescribeDBEngineVersionsInput{}
 
@@ -60,7 +60,7 @@ func TestAccAWSNeptuneOrderableDbInstanceDataSource_preferred(t *testing.T) {
 }
 
 func testAccPreCheckAWSNeptuneOrderableDbInstance(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 
 	input := &neptune.DescribeOrderableDBInstanceOptionsInput{
 		Engine: aws.String("mysql"),
@@ -35,7 +35,7 @@ func dataSourceAwsNetworkAcls() *schema.Resource {
 }
 
 func dataSourceAwsNetworkAclsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeNetworkAclsInput{}
 
@@ -42,7 +42,7 @@ func dataSourceAwsOrganizationsOrganizationalUnits() *schema.Resource {
 }
 
 func dataSourceAwsOrganizationsOrganizationalUnitsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	parent_id := d.Get("parent_id").(string)
 
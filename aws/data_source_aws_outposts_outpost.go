@@ -54,7 +54,7 @@ func dataSourceAwsOutpostsOutpost() *schema.Resource {
 }
 
 func dataSourceAwsOutpostsOutpostRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).outpostsconn
+	conn := meta.(*AWSClient).outpostsconn()
 
 	input := &outposts.ListOutpostsInput{}
 
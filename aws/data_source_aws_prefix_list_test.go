@@ -59,7 +59,7 @@ func TestAccDataSourceAwsPrefixList_nameDoesNotOverrideFilter(t *testing.T) {
 
 func testAccDataSourceAwsPrefixListCheck(name string) resource.TestCheckFunc {
 	getPrefixListId := func(name string) (string, error) {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := ec2.DescribePrefixListsInput{
 			Filters: buildEC2AttributeFilterList(map[string]string{
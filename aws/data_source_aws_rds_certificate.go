@@ -56,7 +56,7 @@ func dataSourceAwsRdsCertificate() *schema.Resource {
 }
 
 func dataSourceAwsRdsCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	input := &rds.DescribeCertificatesInput{}
 
@@ -52,7 +52,7 @@ func TestAccAWSRDSCertificateDataSource_LatestValidTill(t *testing.T) {
 }
 
 func testAccAWSRDSCertificatePreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	input := &rds.DescribeCertificatesInput{}
 
@@ -102,7 +102,7 @@ func TestAccAWSRDSEngineVersionDataSource_defaultOnly(t *testing.T) {
 }
 
 func testAccAWSRDSEngineVersionPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	input := &rds.DescribeDBEngineVersionsInput{
 		Engine:      aws.String("mysql"),
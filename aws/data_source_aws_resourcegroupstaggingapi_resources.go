@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsResourceGroupsTaggingAPIResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsResourceGroupsTaggingAPIResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn_list": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"resource_type_filters", "tag_filter"},
+			},
+			"resource_type_filters": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      100,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"resource_arn_list"},
+			},
+			"tag_filter": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      50,
+				ConflictsWith: []string{"resource_arn_list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+							},
+						},
+					},
+				},
+			},
+			"resource_tag_mapping_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsResourceGroupsTaggingAPIResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn()
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+	// The API version in use cannot look up resources by ARN,
+	// so the resources of the ARNs' services are filtered here instead.
+	var resourceARNs map[string]bool
+
+	if v, ok := d.GetOk("resource_arn_list"); ok && v.(*schema.Set).Len() > 0 {
+		resourceARNs = make(map[string]bool)
+		services := make(map[string]bool)
+
+		for _, v := range v.(*schema.Set).List() {
+			resourceARN, err := arn.Parse(v.(string))
+
+			if err != nil {
+				return fmt.Errorf("error parsing resource_arn_list ARN (%s): %w", v.(string), err)
+			}
+
+			resourceARNs[v.(string)] = true
+
+			if !services[resourceARN.Service] {
+				services[resourceARN.Service] = true
+				input.ResourceTypeFilters = append(input.ResourceTypeFilters, aws.String(resourceARN.Service))
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypeFilters = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("tag_filter"); ok && len(v.([]interface{})) > 0 {
+		input.TagFilters = expandResourceGroupsTaggingAPITagFilters(v.([]interface{}))
+	}
+
+	var mappings []*resourcegroupstaggingapi.ResourceTagMapping
+
+	err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, mapping := range page.ResourceTagMappingList {
+			if resourceARNs != nil && (mapping == nil || !resourceARNs[aws.StringValue(mapping.ResourceARN)]) {
+				continue
+			}
+
+			mappings = append(mappings, mapping)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error getting Resource Groups Tagging API resources: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).partition)
+
+	if err := d.Set("resource_tag_mapping_list", flattenResourceGroupsTaggingAPIResourceTagMappings(mappings, ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting resource_tag_mapping_list: %w", err)
+	}
+
+	return nil
+}
+
+func expandResourceGroupsTaggingAPITagFilters(tfList []interface{}) []*resourcegroupstaggingapi.TagFilter {
+	var apiObjects []*resourcegroupstaggingapi.TagFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &resourcegroupstaggingapi.TagFilter{
+			Key: aws.String(tfMap["key"].(string)),
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Values = expandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenResourceGroupsTaggingAPIResourceTagMappings(apiObjects []*resourcegroupstaggingapi.ResourceTagMapping, ignoreTagsConfig *keyvaluetags.IgnoreConfig) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"resource_arn": aws.StringValue(apiObject.ResourceARN),
+			"tags":         keyvaluetags.ResourcegroupstaggingapiKeyValueTags(apiObject.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map(),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
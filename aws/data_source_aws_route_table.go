@@ -141,7 +141,7 @@ func dataSourceAwsRouteTable() *schema.Resource {
 }
 
 func dataSourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeRouteTablesInput{}
@@ -6,11 +6,11 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 )
 
 func dataSourceAwsS3Bucket() *schema.Resource {
@@ -55,7 +55,7 @@ func dataSourceAwsS3Bucket() *schema.Resource {
 }
 
 func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -71,12 +71,7 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(bucket)
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "s3",
-		Resource:  bucket,
-	}.String()
-	d.Set("arn", arn)
+	d.Set("arn", tfarn.S3Bucket.New(meta.(*AWSClient).partition, "", "", bucket).String())
 	d.Set("bucket_domain_name", meta.(*AWSClient).PartitionHostname(fmt.Sprintf("%s.s3", bucket)))
 
 	err = bucketLocation(meta.(*AWSClient), d, bucket)
@@ -94,18 +89,18 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func bucketLocation(client *AWSClient, d *schema.ResourceData, bucket string) error {
-	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.s3conn, bucket, func(r *request.Request) {
+	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.s3conn(), bucket, func(r *request.Request) {
 		// By default, GetBucketRegion forces virtual host addressing, which
 		// is not compatible with many non-AWS implementations. Instead, pass
 		// the provider s3_force_path_style configuration, which defaults to
 		// false, but allows override.
-		r.Config.S3ForcePathStyle = client.s3conn.Config.S3ForcePathStyle
+		r.Config.S3ForcePathStyle = client.s3conn().Config.S3ForcePathStyle
 
 		// By default, GetBucketRegion uses anonymous credentials when doing
 		// a HEAD request to get the bucket region. This breaks in aws-cn regions
 		// when the account doesn't have an ICP license to host public content.
 		// Use the current credentials when getting the bucket region.
-		r.Config.Credentials = client.s3conn.Config.Credentials
+		r.Config.Credentials = client.s3conn().Config.Credentials
 	})
 	if err != nil {
 		return err
@@ -121,7 +116,7 @@ func bucketLocation(client *AWSClient, d *schema.ResourceData, bucket string) er
 		d.Set("hosted_zone_id", hostedZoneID)
 	}
 
-	_, websiteErr := client.s3conn.GetBucketWebsite(
+	_, websiteErr := client.s3conn().GetBucketWebsite(
 		&s3.GetBucketWebsiteInput{
 			Bucket: aws.String(bucket),
 		},
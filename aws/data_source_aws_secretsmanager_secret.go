@@ -73,7 +73,7 @@ func dataSourceAwsSecretsManagerSecret() *schema.Resource {
 }
 
 func dataSourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	var secretID string
@@ -50,7 +50,7 @@ func dataSourceAwsSecurityGroup() *schema.Resource {
 }
 
 func dataSourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeSecurityGroupsInput{}
@@ -58,7 +58,7 @@ func dataSourceAwsServiceQuotasServiceQuota() *schema.Resource {
 }
 
 func dataSourceAwsServiceQuotasServiceQuotaRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).servicequotasconn
+	conn := meta.(*AWSClient).servicequotasconn()
 
 	quotaCode := d.Get("quota_code").(string)
 	quotaName := d.Get("quota_name").(string)
@@ -5,10 +5,10 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 )
 
 func dataSourceAwsSsmDocument() *schema.Resource {
@@ -49,7 +49,7 @@ func dataSourceAwsSsmDocument() *schema.Resource {
 }
 
 func dataAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	name := d.Get("name").(string)
 
@@ -71,13 +71,7 @@ func dataAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(resp.Name))
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ssm",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("document/%s", aws.StringValue(resp.Name)),
-	}.String()
+	arn := tfarn.SSMDocument.New(meta.(*AWSClient).partition, meta.(*AWSClient).region, meta.(*AWSClient).accountid, aws.StringValue(resp.Name)).String()
 
 	d.Set("arn", arn)
 	d.Set("name", resp.Name)
@@ -6,9 +6,9 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 )
 
 func dataSourceAwsSsmParameter() *schema.Resource {
@@ -46,7 +46,7 @@ func dataSourceAwsSsmParameter() *schema.Resource {
 }
 
 func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	name := d.Get("name").(string)
 
@@ -65,13 +65,7 @@ func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
 	param := resp.Parameter
 	d.SetId(aws.StringValue(param.Name))
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Service:   "ssm",
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("parameter/%s", strings.TrimPrefix(d.Id(), "/")),
-	}
+	arn := tfarn.SSMParameter.New(meta.(*AWSClient).partition, meta.(*AWSClient).region, meta.(*AWSClient).accountid, strings.TrimPrefix(d.Id(), "/"))
 	d.Set("arn", arn.String())
 	d.Set("name", param.Name)
 	d.Set("type", param.Type)
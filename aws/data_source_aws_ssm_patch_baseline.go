@@ -47,7 +47,7 @@ func dataSourceAwsSsmPatchBaseline() *schema.Resource {
 }
 
 func dataAwsSsmPatchBaselineRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	filters := []*ssm.PatchOrchestratorFilter{
 		{
@@ -29,7 +29,7 @@ func dataSourceAwsSsoAdminInstances() *schema.Resource {
 }
 
 func dataSourceAwsSsoAdminInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	var instances []*ssoadmin.InstanceMetadata
 	var arns, ids []string
@@ -22,7 +22,7 @@ func dataSourceAwsWafRegionalWebAcl() *schema.Resource {
 }
 
 func dataSourceAwsWafRegionalWebAclRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	name := d.Get("name").(string)
 
 	acls := make([]*waf.WebACLSummary, 0)
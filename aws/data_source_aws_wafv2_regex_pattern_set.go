@@ -51,7 +51,7 @@ func dataSourceAwsWafv2RegexPatternSet() *schema.Resource {
 }
 
 func dataSourceAwsWafv2RegexPatternSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 	name := d.Get("name").(string)
 
 	var foundRegexPatternSet *wafv2.RegexPatternSetSummary
@@ -39,7 +39,7 @@ func dataSourceAwsWafv2RuleGroup() *schema.Resource {
 }
 
 func dataSourceAwsWafv2RuleGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 	name := d.Get("name").(string)
 
 	var foundRuleGroup *wafv2.RuleGroupSummary
@@ -73,7 +73,7 @@ func dataSourceAwsWorkspacesBundle() *schema.Resource {
 }
 
 func dataSourceAwsWorkspaceBundleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).workspacesconn
+	conn := meta.(*AWSClient).workspacesconn()
 
 	var bundle *workspaces.WorkspaceBundle
 
@@ -159,7 +159,7 @@ func dataSourceAwsWorkspacesDirectory() *schema.Resource {
 }
 
 func dataSourceAwsWorkspacesDirectoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).workspacesconn
+	conn := meta.(*AWSClient).workspacesconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	directoryID := d.Get("directory_id").(string)
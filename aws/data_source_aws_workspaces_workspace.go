@@ -96,7 +96,7 @@ func dataSourceAwsWorkspacesWorkspace() *schema.Resource {
 }
 
 func dataSourceAwsWorkspacesWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).workspacesconn
+	conn := meta.(*AWSClient).workspacesconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	var workspace *workspaces.Workspace
@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
 func testAccCheckAwsPolicyMatch(resource, attr, expectedPolicy string) resource.TestCheckFunc {
@@ -24,7 +23,7 @@ func testAccCheckAwsPolicyMatch(resource, attr, expectedPolicy string) resource.
 			return fmt.Errorf("Attribute %q not found for %q", attr, resource)
 		}
 
-		areEquivalent, err := awspolicy.PoliciesAreEquivalent(given, expectedPolicy)
+		areEquivalent, err := iamPoliciesAreEquivalent(given, expectedPolicy)
 		if err != nil {
 			return fmt.Errorf("Comparing AWS Policies failed: %s", err)
 		}
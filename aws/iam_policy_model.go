@@ -1,9 +1,11 @@
 package aws
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 )
 
 type IAMPolicyDoc struct {
@@ -38,6 +40,41 @@ type IAMPolicyStatementCondition struct {
 type IAMPolicyStatementPrincipalSet []IAMPolicyStatementPrincipal
 type IAMPolicyStatementConditionSet []IAMPolicyStatementCondition
 
+func (s *IAMPolicyDoc) UnmarshalJSON(b []byte) error {
+	var data struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	s.Version = data.Version
+	s.Id = data.Id
+	s.Statements = nil
+
+	// Statement may be a single statement instead of a list of statements
+	statement := bytes.TrimSpace(data.Statement)
+
+	if len(statement) > 0 && statement[0] == '{' {
+		var out IAMPolicyStatement
+		if err := json.Unmarshal(statement, &out); err != nil {
+			return err
+		}
+
+		s.Statements = []*IAMPolicyStatement{&out}
+		return nil
+	}
+
+	if len(statement) > 0 {
+		return json.Unmarshal(statement, &s.Statements)
+	}
+
+	return nil
+}
+
 func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	// adopt newDoc's Id
 	if len(newDoc.Id) > 0 {
@@ -143,7 +180,11 @@ func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range value.([]interface{}) {
-					values = append(values, v.(string))
+					s, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", v)
+					}
+					values = append(values, s)
 				}
 				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: values})
 			default:
@@ -185,22 +226,33 @@ func (cs IAMPolicyStatementConditionSet) MarshalJSON() ([]byte, error) {
 func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	var out IAMPolicyStatementConditionSet
 
+	// Numbers are decoded as json.Number to keep their original text
 	var data map[string]map[string]interface{}
-	if err := json.Unmarshal(b, &data); err != nil {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
 		return err
 	}
 
 	for test_key, test_value := range data {
 		for var_key, var_values := range test_value {
 			switch var_values := var_values.(type) {
-			case string:
-				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					value, err := iamPolicyConditionValueString(v)
+					if err != nil {
+						return err
+					}
+					values = append(values, value)
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
+			default:
+				value, err := iamPolicyConditionValueString(var_values)
+				if err != nil {
+					return err
+				}
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{value}})
 			}
 		}
 	}
@@ -209,6 +261,21 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// iamPolicyConditionValueString returns a condition value as a string.
+// IAM accepts boolean and number condition values, which are equivalent to their string representation.
+func iamPolicyConditionValueString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("Unsupported data type %T for IAMPolicyStatementConditionSet.Values", v)
+	}
+}
+
 func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	if len(lI) == 1 {
 		return lI[0].(string)
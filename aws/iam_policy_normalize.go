@@ -0,0 +1,311 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// iamPolicyDefaultVersion is the policy language version of policies without a Version.
+const iamPolicyDefaultVersion = "2008-10-17"
+
+// iamPolicyAccountRootPrincipalRegexp matches the ARN IAM rewrites AWS account ID principals to.
+var iamPolicyAccountRootPrincipalRegexp = regexp.MustCompile(`^arn:[^:]+:iam::(\d{12}):root$`)
+
+// iamPoliciesAreEquivalent returns whether two IAM policy JSON documents are semantically equivalent.
+// See IAMPolicyDoc.Normalize for the differences which are ignored.
+func iamPoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	normalizedPolicy1, err := normalizeIAMPolicyJSON(policy1)
+
+	if err != nil {
+		return false, err
+	}
+
+	normalizedPolicy2, err := normalizeIAMPolicyJSON(policy2)
+
+	if err != nil {
+		return false, err
+	}
+
+	return normalizedPolicy1 == normalizedPolicy2, nil
+}
+
+// normalizeIAMPolicyJSON returns the canonical JSON of an IAM policy document.
+func normalizeIAMPolicyJSON(policy string) (string, error) {
+	doc := &IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return "", fmt.Errorf("error parsing IAM policy: %w", err)
+	}
+
+	if err := doc.Normalize(); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", fmt.Errorf("error marshalling IAM policy: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// Normalize rewrites the policy document into a canonical form, so that semantically
+// equivalent policy documents are equal. The following differences are removed:
+//  * A missing Version and the default 2008-10-17 Version
+//  * Single values and lists of values, and the order and duplicates of list values
+//  * The order and duplicates of statements
+//  * Action name capitalization
+//  * Condition key capitalization, and values of the same condition key across conditions
+//  * The "*" principal and the {"AWS": "*"} principal
+//  * AWS account ID principals and the account root user ARNs IAM rewrites them to
+func (s *IAMPolicyDoc) Normalize() error {
+	if s.Version == "" {
+		s.Version = iamPolicyDefaultVersion
+	}
+
+	statements := make([]*IAMPolicyStatement, 0, len(s.Statements))
+	keys := make(map[*IAMPolicyStatement]string, len(s.Statements))
+	seen := make(map[string]bool, len(s.Statements))
+
+	for _, statement := range s.Statements {
+		if statement == nil {
+			continue
+		}
+
+		if err := statement.Normalize(); err != nil {
+			return err
+		}
+
+		b, err := json.Marshal(statement)
+
+		if err != nil {
+			return fmt.Errorf("error marshalling IAM policy statement: %w", err)
+		}
+
+		key := string(b)
+
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		keys[statement] = key
+		statements = append(statements, statement)
+	}
+
+	sort.Slice(statements, func(i, j int) bool {
+		return keys[statements[i]] < keys[statements[j]]
+	})
+
+	s.Statements = statements
+
+	return nil
+}
+
+// Normalize rewrites the policy statement into a canonical form.
+// See IAMPolicyDoc.Normalize.
+func (s *IAMPolicyStatement) Normalize() error {
+	var err error
+
+	if s.Actions, err = iamPolicyNormalizeValues(s.Actions, strings.ToLower); err != nil {
+		return fmt.Errorf("error normalizing IAM policy statement Action: %w", err)
+	}
+
+	if s.NotActions, err = iamPolicyNormalizeValues(s.NotActions, strings.ToLower); err != nil {
+		return fmt.Errorf("error normalizing IAM policy statement NotAction: %w", err)
+	}
+
+	if s.Resources, err = iamPolicyNormalizeValues(s.Resources, nil); err != nil {
+		return fmt.Errorf("error normalizing IAM policy statement Resource: %w", err)
+	}
+
+	if s.NotResources, err = iamPolicyNormalizeValues(s.NotResources, nil); err != nil {
+		return fmt.Errorf("error normalizing IAM policy statement NotResource: %w", err)
+	}
+
+	if s.Principals, err = s.Principals.normalize(); err != nil {
+		return fmt.Errorf("error normalizing IAM policy statement Principal: %w", err)
+	}
+
+	if s.NotPrincipals, err = s.NotPrincipals.normalize(); err != nil {
+		return fmt.Errorf("error normalizing IAM policy statement NotPrincipal: %w", err)
+	}
+
+	if s.Conditions, err = s.Conditions.normalize(); err != nil {
+		return fmt.Errorf("error normalizing IAM policy statement Condition: %w", err)
+	}
+
+	return nil
+}
+
+func (ps IAMPolicyStatementPrincipalSet) normalize() (IAMPolicyStatementPrincipalSet, error) {
+	if len(ps) == 0 {
+		return nil, nil
+	}
+
+	identifiersByType := make(map[string][]string)
+
+	for _, p := range ps {
+		principalType := p.Type
+
+		if principalType == "*" {
+			principalType = "AWS"
+		}
+
+		identifiers, err := iamPolicyValues(p.Identifiers)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, identifier := range identifiers {
+			if principalType == "AWS" {
+				if m := iamPolicyAccountRootPrincipalRegexp.FindStringSubmatch(identifier); m != nil {
+					identifier = m[1]
+				}
+			}
+
+			identifiersByType[principalType] = append(identifiersByType[principalType], identifier)
+		}
+	}
+
+	principalTypes := make([]string, 0, len(identifiersByType))
+
+	for principalType := range identifiersByType {
+		principalTypes = append(principalTypes, principalType)
+	}
+
+	sort.Strings(principalTypes)
+
+	result := make(IAMPolicyStatementPrincipalSet, 0, len(principalTypes))
+
+	for _, principalType := range principalTypes {
+		result = append(result, IAMPolicyStatementPrincipal{
+			Type:        principalType,
+			Identifiers: iamPolicySortedUniqueValues(identifiersByType[principalType]),
+		})
+	}
+
+	return result, nil
+}
+
+func (cs IAMPolicyStatementConditionSet) normalize() (IAMPolicyStatementConditionSet, error) {
+	if len(cs) == 0 {
+		return nil, nil
+	}
+
+	type conditionKey struct {
+		test, variable string
+	}
+
+	valuesByKey := make(map[conditionKey][]string)
+
+	for _, c := range cs {
+		values, err := iamPolicyValues(c.Values)
+
+		if err != nil {
+			return nil, err
+		}
+
+		key := conditionKey{test: c.Test, variable: strings.ToLower(c.Variable)}
+		valuesByKey[key] = append(valuesByKey[key], values...)
+	}
+
+	keys := make([]conditionKey, 0, len(valuesByKey))
+
+	for key := range valuesByKey {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].test != keys[j].test {
+			return keys[i].test < keys[j].test
+		}
+
+		return keys[i].variable < keys[j].variable
+	})
+
+	result := make(IAMPolicyStatementConditionSet, 0, len(keys))
+
+	for _, key := range keys {
+		result = append(result, IAMPolicyStatementCondition{
+			Test:     key.test,
+			Variable: key.variable,
+			Values:   iamPolicySortedUniqueValues(valuesByKey[key]),
+		})
+	}
+
+	return result, nil
+}
+
+// iamPolicyNormalizeValues returns the sorted unique values of a policy element,
+// optionally transformed, or nil if there are no values.
+func iamPolicyNormalizeValues(v interface{}, transform func(string) string) (interface{}, error) {
+	values, err := iamPolicyValues(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	if transform != nil {
+		for i, value := range values {
+			values[i] = transform(value)
+		}
+	}
+
+	return iamPolicySortedUniqueValues(values), nil
+}
+
+// iamPolicyValues returns the values of a policy element, which may be a single value or a list of values.
+func iamPolicyValues(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return append([]string{}, v...), nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+
+		for _, value := range v {
+			s, ok := value.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("unsupported data type %T in list", value)
+			}
+
+			values = append(values, s)
+		}
+
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T", v)
+	}
+}
+
+func iamPolicySortedUniqueValues(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+
+		seen[value] = true
+		result = append(result, value)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
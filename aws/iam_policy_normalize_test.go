@@ -0,0 +1,358 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestIAMPoliciesAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Policy1    string
+		Policy2    string
+		Equivalent bool
+		ExpectErr  bool
+	}{
+		{
+			Name:       "identical",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:    "whitespace",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    }
+  ]
+}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "single action and list",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "reordered actions",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "duplicate actions",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:GetObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "action capitalization",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"S3:getobject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different actions",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "action and not action",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "reordered not actions",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["iam:*","sts:*"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["sts:*","iam:*"],"Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "single resource and list",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::bucket/*"]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "reordered resources",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"]}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3:::bucket/*","arn:aws:s3:::bucket"]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "resource capitalization",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/key"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/KEY"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "resource and not resource",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::bucket/*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "wildcard principal and AWS wildcard principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "wildcard principal and AWS wildcard principal list",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "wildcard principal and service principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "account ID principal and root ARN",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "account ID principal and root ARN in other partition",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012"]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws-cn:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "account ID principal and user ARN",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:user/test"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "different account ID principals",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "reordered principal identifiers",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/a","arn:aws:iam::123456789012:role/b"]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/b","arn:aws:iam::123456789012:role/a"]},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "duplicate principal identifiers after account ID rewrite",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "multiple principal types",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012","Service":["lambda.amazonaws.com","ec2.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com","lambda.amazonaws.com"],"AWS":["arn:aws:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different principal types",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Federated":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "not principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"123456789012"},"Action":"s3:*","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":"s3:*","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "principal and not principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"AWS":"123456789012"},"Action":"s3:*","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"123456789012"},"Action":"s3:*","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "single condition value and list",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-12345678"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":["vpc-12345678"]}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "reordered condition values",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":["vpc-1","vpc-2"]}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":["vpc-2","vpc-1"]}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "reordered conditions",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-1"},"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"},"StringEquals":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "condition key capitalization",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:sourcevpc":"vpc-1"}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "condition value capitalization",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"VPC-1"}}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "boolean condition value and string",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different boolean condition values",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true}}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "number condition value and string",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"NumericLessThan":{"s3:TlsVersion":[1.2]}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"NumericLessThan":{"s3:TlsVersion":"1.2"}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different condition operators",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringLike":{"aws:SourceVpc":"vpc-1"}}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "single statement and list",
+			Policy1:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "reordered statements",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "duplicate statements",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different statement IDs",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "different effects",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "missing version and default version",
+			Policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "missing version and current version",
+			Policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "different policy IDs",
+			Policy1:    `{"Version":"2012-10-17","Id":"a","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Id":"b","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:      "invalid JSON",
+			Policy1:   `{"Version":"2012-10-17","Statement":[`,
+			Policy2:   `{"Version":"2012-10-17","Statement":[]}`,
+			ExpectErr: true,
+		},
+		{
+			Name:      "unsupported action type",
+			Policy1:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":1,"Resource":"*"}]}`,
+			Policy2:   `{"Version":"2012-10-17","Statement":[]}`,
+			ExpectErr: true,
+		},
+		{
+			Name:      "unsupported condition value type",
+			Policy1:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":{"a":"b"}}}}]}`,
+			Policy2:   `{"Version":"2012-10-17","Statement":[]}`,
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			equivalent, err := iamPoliciesAreEquivalent(testCase.Policy1, testCase.Policy2)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if equivalent != testCase.Equivalent {
+				t.Errorf("got equivalent %t, expected %t", equivalent, testCase.Equivalent)
+			}
+
+			// Equivalence is symmetric
+			if equivalent, err := iamPoliciesAreEquivalent(testCase.Policy2, testCase.Policy1); err != nil || equivalent != testCase.Equivalent {
+				t.Errorf("got reversed equivalent %t (error: %v), expected %t", equivalent, err, testCase.Equivalent)
+			}
+		})
+	}
+}
+
+func TestNormalizeIAMPolicyJSON(t *testing.T) {
+	policy := `{
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": ["SQS:SendMessage", "sqs:sendmessage"],
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:queue",
+    "Condition": {
+      "ArnEquals": {"aws:SourceArn": "arn:aws:sns:us-west-2:123456789012:topic"}
+    }
+  }
+}`
+	expected := `{"Version":"2008-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":["sqs:sendmessage"],"Resource":["arn:aws:sqs:us-west-2:123456789012:queue"],"Principal":{"AWS":["*"]},"Condition":{"ArnEquals":{"aws:sourcearn":["arn:aws:sns:us-west-2:123456789012:topic"]}}}]}`
+
+	got, err := normalizeIAMPolicyJSON(policy)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	// Normalization is idempotent
+	if got2, err := normalizeIAMPolicyJSON(got); err != nil || got2 != got {
+		t.Errorf("got %s (error: %v) normalizing again, expected %s", got2, err, got)
+	}
+}
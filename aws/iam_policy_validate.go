@@ -0,0 +1,395 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// iamPolicyType describes the size limit of a kind of IAM policy document.
+type iamPolicyType struct {
+	// Description of the policy type used in error messages.
+	Description string
+
+	// MaxLength is the maximum number of characters of the policy document.
+	// Optional, defaults to no limit.
+	MaxLength int
+
+	// IgnoreWhitespace excludes whitespace characters from the length,
+	// as IAM does for identity-based and trust policies.
+	IgnoreWhitespace bool
+}
+
+// Policy types with documented size limits. Inline policy limits are for the aggregate
+// size of all inline policies of an identity, so are upper bounds for a single policy.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html
+var (
+	iamPolicyTypeManaged      = iamPolicyType{Description: "IAM managed policy", MaxLength: 6144, IgnoreWhitespace: true}
+	iamPolicyTypeInlineGroup  = iamPolicyType{Description: "IAM group inline policy", MaxLength: 5120, IgnoreWhitespace: true}
+	iamPolicyTypeInlineRole   = iamPolicyType{Description: "IAM role inline policy", MaxLength: 10240, IgnoreWhitespace: true}
+	iamPolicyTypeInlineUser   = iamPolicyType{Description: "IAM user inline policy", MaxLength: 2048, IgnoreWhitespace: true}
+	iamPolicyTypeRoleTrust    = iamPolicyType{Description: "IAM role trust policy", MaxLength: 4096, IgnoreWhitespace: true} // Default quota is 2048, the maximum is 4096
+	iamPolicyTypeIdentity     = iamPolicyType{Description: "identity-based policy"}
+	iamPolicyTypeResource     = iamPolicyType{Description: "resource-based policy"}
+	iamPolicyTypeCodeArtifact = iamPolicyType{Description: "CodeArtifact permissions policy", MaxLength: 5120}
+	iamPolicyTypeEcr          = iamPolicyType{Description: "ECR repository policy", MaxLength: 10240}
+	iamPolicyTypeGlue         = iamPolicyType{Description: "Glue resource policy", MaxLength: 10240}
+	iamPolicyTypeKmsKey       = iamPolicyType{Description: "KMS key policy", MaxLength: 32768}
+	iamPolicyTypeMediaStore   = iamPolicyType{Description: "MediaStore container policy", MaxLength: 8192}
+	iamPolicyTypeS3Bucket     = iamPolicyType{Description: "S3 bucket policy", MaxLength: 20480}
+	iamPolicyTypeSecrets      = iamPolicyType{Description: "Secrets Manager secret policy", MaxLength: 20480}
+	iamPolicyTypeSsoAdmin     = iamPolicyType{Description: "SSO permission set inline policy", MaxLength: 10240}
+	iamPolicyTypeTransferUser = iamPolicyType{Description: "Transfer user scope-down policy", MaxLength: 2048}
+)
+
+// iamPolicyStructureError is a problem with the top-level structure of a policy document,
+// e.g. a missing Statement. Policy attributes have historically accepted any JSON object,
+// such as {}, so validators report these as warnings rather than errors.
+type iamPolicyStructureError struct {
+	error
+}
+
+var (
+	iamPolicyDocumentKeys = []string{
+		"Id",
+		"Statement",
+		"Version",
+	}
+
+	iamPolicyStatementKeys = []string{
+		"Action",
+		"Condition",
+		"Effect",
+		"NotAction",
+		"NotPrincipal",
+		"NotResource",
+		"Principal",
+		"Resource",
+		"Sid",
+	}
+
+	iamPolicyVersions = []string{
+		"2008-10-17",
+		"2012-10-17",
+	}
+
+	iamPolicyEffects = []string{
+		"Allow",
+		"Deny",
+	}
+
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+	iamPolicyConditionOperators = []string{
+		"ArnEquals",
+		"ArnLike",
+		"ArnNotEquals",
+		"ArnNotLike",
+		"BinaryEquals",
+		"Bool",
+		"DateEquals",
+		"DateGreaterThan",
+		"DateGreaterThanEquals",
+		"DateLessThan",
+		"DateLessThanEquals",
+		"DateNotEquals",
+		"IpAddress",
+		"NotIpAddress",
+		"NumericEquals",
+		"NumericGreaterThan",
+		"NumericGreaterThanEquals",
+		"NumericLessThan",
+		"NumericLessThanEquals",
+		"NumericNotEquals",
+		"StringEquals",
+		"StringEqualsIgnoreCase",
+		"StringLike",
+		"StringNotEquals",
+		"StringNotEqualsIgnoreCase",
+		"StringNotLike",
+	}
+)
+
+// iamPolicyDocumentErrors returns the errors IAM would report for a policy document
+// which is valid JSON. Problems with the top-level structure of the document are
+// returned as iamPolicyStructureError. It checks:
+//  * The size limit of the policy type
+//  * Unknown policy and statement elements
+//  * Unsupported Version and Effect values
+//  * Malformed Resource and NotResource ARNs
+//  * Unknown condition operators
+//  * Duplicate statement IDs
+//  * Elements which may not be used together in a statement
+func iamPolicyDocumentErrors(policy string, policyType iamPolicyType) []error {
+	var errs []error
+
+	if policyType.MaxLength > 0 {
+		if length := iamPolicyLength(policy, policyType.IgnoreWhitespace); length > policyType.MaxLength {
+			errs = append(errs, fmt.Errorf("%s length (%d) exceeds the limit of %d characters", policyType.Description, length, policyType.MaxLength))
+		}
+	}
+
+	var doc map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return append(errs, iamPolicyStructureError{fmt.Errorf("policy must be a JSON object: %w", err)})
+	}
+
+	for _, err := range iamPolicyUnknownKeyErrors("policy", doc, iamPolicyDocumentKeys) {
+		errs = append(errs, iamPolicyStructureError{err})
+	}
+
+	if v, ok := doc["Version"]; ok {
+		var version string
+
+		if err := json.Unmarshal(v, &version); err != nil || !iamPolicyStringInSlice(version, iamPolicyVersions, false) {
+			errs = append(errs, iamPolicyStructureError{fmt.Errorf("policy Version must be one of %s, got %s", strings.Join(iamPolicyVersions, ", "), v)})
+		}
+	}
+
+	v, ok := doc["Statement"]
+
+	if !ok {
+		return append(errs, iamPolicyStructureError{fmt.Errorf("policy must contain a Statement")})
+	}
+
+	var statements []json.RawMessage
+
+	// Statement may be a single statement instead of a list of statements
+	if err := json.Unmarshal(v, &statements); err != nil {
+		statements = []json.RawMessage{v}
+	}
+
+	sids := make(map[string]bool, len(statements))
+
+	for i, rawStatement := range statements {
+		prefix := fmt.Sprintf("statement %d", i)
+
+		var statement map[string]json.RawMessage
+
+		if err := json.Unmarshal(rawStatement, &statement); err != nil {
+			errs = append(errs, fmt.Errorf("%s must be a JSON object", prefix))
+			continue
+		}
+
+		errs = append(errs, iamPolicyStatementErrors(prefix, statement)...)
+
+		var sid string
+
+		if v, ok := statement["Sid"]; ok && json.Unmarshal(v, &sid) == nil && sid != "" {
+			if sids[sid] {
+				errs = append(errs, fmt.Errorf("%s: duplicate Sid %q", prefix, sid))
+			}
+
+			sids[sid] = true
+		}
+	}
+
+	return errs
+}
+
+func iamPolicyStatementErrors(prefix string, statement map[string]json.RawMessage) []error {
+	errs := iamPolicyUnknownKeyErrors(prefix, statement, iamPolicyStatementKeys)
+
+	for _, keys := range [][2]string{{"Action", "NotAction"}, {"Resource", "NotResource"}, {"Principal", "NotPrincipal"}} {
+		_, ok1 := statement[keys[0]]
+		_, ok2 := statement[keys[1]]
+
+		if ok1 && ok2 {
+			errs = append(errs, fmt.Errorf("%s: %s and %s cannot be used together", prefix, keys[0], keys[1]))
+		}
+	}
+
+	if _, ok := statement["Action"]; !ok {
+		if _, ok := statement["NotAction"]; !ok {
+			errs = append(errs, fmt.Errorf("%s: one of Action or NotAction is required", prefix))
+		}
+	}
+
+	var effect string
+
+	if v, ok := statement["Effect"]; !ok {
+		errs = append(errs, fmt.Errorf("%s: Effect is required", prefix))
+	} else if err := json.Unmarshal(v, &effect); err != nil || !iamPolicyStringInSlice(effect, iamPolicyEffects, false) {
+		errs = append(errs, fmt.Errorf("%s: Effect must be one of %s, got %s", prefix, strings.Join(iamPolicyEffects, ", "), v))
+	}
+
+	for _, key := range []string{"Action", "NotAction"} {
+		if v, ok := statement[key]; ok {
+			if _, err := iamPolicyRawValues(v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s: %w", prefix, key, err))
+			}
+		}
+	}
+
+	for _, key := range []string{"Resource", "NotResource"} {
+		v, ok := statement[key]
+
+		if !ok {
+			continue
+		}
+
+		values, err := iamPolicyRawValues(v)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: %w", prefix, key, err))
+			continue
+		}
+
+		for _, value := range values {
+			if value == "*" {
+				continue
+			}
+
+			if _, err := arn.Parse(value); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s %q is not a valid ARN: %w", prefix, key, value, err))
+			}
+		}
+	}
+
+	for _, key := range []string{"Principal", "NotPrincipal"} {
+		v, ok := statement[key]
+
+		if !ok {
+			continue
+		}
+
+		var s string
+
+		if err := json.Unmarshal(v, &s); err == nil {
+			if s != "*" {
+				errs = append(errs, fmt.Errorf("%s: %s must be \"*\" or an object, got %s", prefix, key, v))
+			}
+
+			continue
+		}
+
+		var principals map[string]json.RawMessage
+
+		if err := json.Unmarshal(v, &principals); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s must be \"*\" or an object, got %s", prefix, key, v))
+			continue
+		}
+
+		for _, principalType := range iamPolicySortedKeys(principals) {
+			if _, err := iamPolicyRawValues(principals[principalType]); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s %s: %w", prefix, key, principalType, err))
+			}
+		}
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		var conditions map[string]json.RawMessage
+
+		if err := json.Unmarshal(v, &conditions); err != nil {
+			errs = append(errs, fmt.Errorf("%s: Condition must be an object, got %s", prefix, v))
+		} else {
+			for _, operator := range iamPolicySortedKeys(conditions) {
+				if !iamPolicyConditionOperatorValid(operator) {
+					errs = append(errs, fmt.Errorf("%s: unknown Condition operator %q", prefix, operator))
+				}
+			}
+
+			var cs IAMPolicyStatementConditionSet
+
+			if err := json.Unmarshal(v, &cs); err != nil {
+				errs = append(errs, fmt.Errorf("%s: Condition: %w", prefix, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+// iamPolicyConditionOperatorValid returns whether the condition operator exists, including
+// the ForAllValues and ForAnyValue set operator prefixes and IfExists suffix.
+func iamPolicyConditionOperatorValid(operator string) bool {
+	if operator == "Null" {
+		return true
+	}
+
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if len(operator) > len(prefix) && strings.EqualFold(operator[:len(prefix)], prefix) {
+			operator = operator[len(prefix):]
+			break
+		}
+	}
+
+	if suffix := "IfExists"; len(operator) > len(suffix) && strings.EqualFold(operator[len(operator)-len(suffix):], suffix) {
+		operator = operator[:len(operator)-len(suffix)]
+	}
+
+	return iamPolicyStringInSlice(operator, iamPolicyConditionOperators, true)
+}
+
+// iamPolicyRawValues returns the values of a policy element, which must be a string or a list of strings.
+func iamPolicyRawValues(v json.RawMessage) ([]string, error) {
+	var value string
+
+	if err := json.Unmarshal(v, &value); err == nil {
+		return []string{value}, nil
+	}
+
+	var values []string
+
+	if err := json.Unmarshal(v, &values); err != nil {
+		return nil, fmt.Errorf("must be a string or a list of strings, got %s", v)
+	}
+
+	return values, nil
+}
+
+func iamPolicyUnknownKeyErrors(prefix string, m map[string]json.RawMessage, keys []string) []error {
+	var errs []error
+
+	for _, key := range iamPolicySortedKeys(m) {
+		if !iamPolicyStringInSlice(key, keys, false) {
+			errs = append(errs, fmt.Errorf("%s: unknown element %q, expected one of %s", prefix, key, strings.Join(keys, ", ")))
+		}
+	}
+
+	return errs
+}
+
+func iamPolicySortedKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func iamPolicyStringInSlice(s string, values []string, ignoreCase bool) bool {
+	for _, value := range values {
+		if s == value || (ignoreCase && strings.EqualFold(s, value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// iamPolicyLength returns the number of characters in the policy document.
+func iamPolicyLength(policy string, ignoreWhitespace bool) int {
+	if !ignoreWhitespace {
+		return utf8.RuneCountInString(policy)
+	}
+
+	length := 0
+
+	for _, r := range policy {
+		if !unicode.IsSpace(r) {
+			length++
+		}
+	}
+
+	return length
+}
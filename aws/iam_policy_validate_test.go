@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestIAMPolicyDocumentErrors(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Policy      string
+		PolicyType  iamPolicyType
+		ErrCount    int
+		ErrorRegexp *regexp.Regexp
+	}{
+		{
+			Name:       "valid",
+			Policy:     `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"]}]}`,
+			PolicyType: iamPolicyTypeManaged,
+		},
+		{
+			Name:       "valid single statement",
+			Policy:     `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			PolicyType: iamPolicyTypeManaged,
+		},
+		{
+			Name:       "valid trust policy",
+			Policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com","AWS":["arn:aws:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+			PolicyType: iamPolicyTypeRoleTrust,
+		},
+		{
+			Name:       "valid resource policy",
+			Policy:     `{"Version":"2008-10-17","Id":"queue","Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"arn:aws:sqs:us-west-2:123456789012:queue","Condition":{"ArnEquals":{"aws:SourceArn":"arn:aws:sns:us-west-2:123456789012:topic"}}}]}`,
+			PolicyType: iamPolicyTypeResource,
+		},
+		{
+			Name:       "valid condition operators",
+			Policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"iam:*","NotResource":"arn:aws:iam::*:role/${aws:username}","Condition":{"StringLikeIfExists":{"aws:PrincipalTag/team":"a*"},"ForAnyValue:StringEquals":{"aws:TagKeys":["a","b"]},"Null":{"aws:MultiFactorAuthAge":true},"NumericLessThan":{"aws:MultiFactorAuthAge":3600}}}]}`,
+			PolicyType: iamPolicyTypeManaged,
+		},
+		{
+			Name:       "duplicate empty Sids",
+			Policy:     `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			PolicyType: iamPolicyTypeManaged,
+		},
+		{
+			Name:        "not an object",
+			Policy:      `[]`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`must be a JSON object`),
+		},
+		{
+			Name:        "missing Statement",
+			Policy:      `{"Version":"2012-10-17"}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`must contain a Statement`),
+		},
+		{
+			Name:        "unknown top-level key",
+			Policy:      `{"Version":"2012-10-17","Statements":[],"Statement":[]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`unknown element "Statements"`),
+		},
+		{
+			Name:        "unknown statement key",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resources":"*"}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`statement 0: unknown element "Resources"`),
+		},
+		{
+			Name:        "unsupported Version",
+			Policy:      `{"Version":"2012-10-18","Statement":[]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`Version must be one of`),
+		},
+		{
+			Name:        "invalid Effect",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`Effect must be one of Allow, Deny, got "allow"`),
+		},
+		{
+			Name:        "missing Effect",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Resource":"*"}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`Effect is required`),
+		},
+		{
+			Name:        "missing Action",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`one of Action or NotAction is required`),
+		},
+		{
+			Name:        "Action and NotAction",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotAction":"s3:PutObject","Resource":"*"}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`Action and NotAction cannot be used together`),
+		},
+		{
+			Name:        "nested Resource list",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":[["*"]]}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`Resource: must be a string or a list of strings`),
+		},
+		{
+			Name:        "malformed Resource ARN",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::bucket/*","bucket/*"]}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`Resource "bucket/\*" is not a valid ARN`),
+		},
+		{
+			Name:        "malformed NotResource ARN",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3"}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`NotResource "arn:aws:s3" is not a valid ARN`),
+		},
+		{
+			Name:        "string Principal",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"arn:aws:iam::123456789012:root","Action":"sts:AssumeRole"}]}`,
+			PolicyType:  iamPolicyTypeRoleTrust,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`Principal must be "\*" or an object`),
+		},
+		{
+			Name:        "unknown condition operator",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEqual":{"aws:SourceVpc":"vpc-1"},"NullIfExists":{"aws:SourceVpc":true}}}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    2,
+			ErrorRegexp: regexp.MustCompile(`unknown Condition operator "NullIfExists"`),
+		},
+		{
+			Name:        "invalid condition value",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpc":{"a":"b"}}}}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`Condition: Unsupported data type`),
+		},
+		{
+			Name:        "duplicate Sids",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`statement 1: duplicate Sid "A"`),
+		},
+		{
+			Name:        "multiple errors",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Permit","Action":"s3:GetObject","Resource":"bucket"}]}`,
+			PolicyType:  iamPolicyTypeManaged,
+			ErrCount:    2,
+			ErrorRegexp: regexp.MustCompile(`Effect must be one of`),
+		},
+		{
+			Name:        "exceeds size limit",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["` + strings.Repeat("arn:aws:s3:::bucket/key", 100) + `"]}]}`,
+			PolicyType:  iamPolicyTypeInlineUser,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`IAM user inline policy length \(\d+\) exceeds the limit of 2048 characters`),
+		},
+		{
+			Name:       "whitespace within size limit",
+			Policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}` + strings.Repeat(" \n", 2048),
+			PolicyType: iamPolicyTypeInlineUser,
+		},
+		{
+			Name:        "whitespace exceeds size limit",
+			Policy:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}` + strings.Repeat(" ", 2048),
+			PolicyType:  iamPolicyTypeTransferUser,
+			ErrCount:    1,
+			ErrorRegexp: regexp.MustCompile(`exceeds the limit of 2048 characters`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			errs := iamPolicyDocumentErrors(testCase.Policy, testCase.PolicyType)
+
+			if len(errs) != testCase.ErrCount {
+				t.Fatalf("got %d errors (%v), expected %d", len(errs), errs, testCase.ErrCount)
+			}
+
+			if testCase.ErrorRegexp == nil {
+				return
+			}
+
+			for _, err := range errs {
+				if testCase.ErrorRegexp.MatchString(err.Error()) {
+					return
+				}
+			}
+
+			t.Errorf("expected an error matching %q, got %v", testCase.ErrorRegexp, errs)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Package apimetrics counts AWS Go SDK API calls, errors, throttles, retries
+// and latency per service and operation.
+package apimetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// EnvPath is the environment variable naming a file to which the summary is
+// written as JSON, instead of logging it.
+const EnvPath = "TF_AWS_API_METRICS_PATH"
+
+const (
+	completeHandlerName = "terraform-provider-aws.apimetrics.Complete"
+	retryHandlerName    = "terraform-provider-aws.apimetrics.Retry"
+)
+
+// logf is replaced in testing.
+var logf = log.Printf
+
+// Metrics are the totals for a single service operation.
+type Metrics struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	Calls     int64  `json:"calls"`
+	Errors    int64  `json:"errors"`
+	Throttles int64  `json:"throttles"`
+	Retries   int64  `json:"retries"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+type key struct {
+	service   string
+	operation string
+}
+
+// Collector accumulates metrics for all clients it is installed on.
+// It is safe for concurrent use.
+type Collector struct {
+	mu      sync.Mutex
+	metrics map[key]*Metrics
+}
+
+// NewCollector returns an empty Collector.
+func NewCollector() *Collector {
+	return &Collector{
+		metrics: make(map[key]*Metrics),
+	}
+}
+
+// Install adds handlers recording every request made with the handlers.
+func (c *Collector) Install(handlers *request.Handlers) {
+	// Retry handlers run after every failed attempt.
+	handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: retryHandlerName,
+		Fn: func(r *request.Request) {
+			if request.IsErrorThrottle(r.Error) {
+				c.update(r, func(m *Metrics) {
+					m.Throttles++
+				})
+			}
+		},
+	})
+
+	// Complete handlers run once per request, after all retries.
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: completeHandlerName,
+		Fn: func(r *request.Request) {
+			var latency time.Duration
+
+			if !r.Time.IsZero() {
+				latency = time.Since(r.Time)
+			}
+
+			c.update(r, func(m *Metrics) {
+				m.Calls++
+				m.Retries += int64(r.RetryCount)
+				m.LatencyMs += latency.Milliseconds()
+
+				if r.Error != nil {
+					m.Errors++
+				}
+			})
+		},
+	})
+}
+
+func (c *Collector) update(r *request.Request, fn func(*Metrics)) {
+	k := key{service: r.ClientInfo.ServiceName}
+
+	if r.Operation != nil {
+		k.operation = r.Operation.Name
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, ok := c.metrics[k]
+
+	if !ok {
+		m = &Metrics{
+			Service:   k.service,
+			Operation: k.operation,
+		}
+		c.metrics[k] = m
+	}
+
+	fn(m)
+}
+
+// Summary returns a copy of the metrics of every operation called, ordered by
+// descending total latency.
+func (c *Collector) Summary() []Metrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	summary := make([]Metrics, 0, len(c.metrics))
+
+	for _, m := range c.metrics {
+		summary = append(summary, *m)
+	}
+
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].LatencyMs != summary[j].LatencyMs {
+			return summary[i].LatencyMs > summary[j].LatencyMs
+		}
+
+		if summary[i].Service != summary[j].Service {
+			return summary[i].Service < summary[j].Service
+		}
+
+		return summary[i].Operation < summary[j].Operation
+	})
+
+	return summary
+}
+
+// WriteSummary writes the summary as JSON to the given file path, or logs
+// one line per operation if the path is empty. Nothing is written if no
+// requests were recorded.
+func (c *Collector) WriteSummary(path string) error {
+	summary := c.Summary()
+
+	if len(summary) == 0 {
+		return nil
+	}
+
+	if path != "" {
+		b, err := json.MarshalIndent(summary, "", "  ")
+
+		if err != nil {
+			return fmt.Errorf("error encoding AWS API metrics: %w", err)
+		}
+
+		if err := ioutil.WriteFile(path, b, 0644); err != nil {
+			return fmt.Errorf("error writing AWS API metrics (%s): %w", path, err)
+		}
+
+		return nil
+	}
+
+	for _, m := range summary {
+		logf("[INFO] AWS API metrics: service=%s operation=%s calls=%d errors=%d throttles=%d retries=%d latency_ms=%d",
+			m.Service, m.Operation, m.Calls, m.Errors, m.Throttles, m.Retries, m.LatencyMs)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package apimetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func testRequest(service, operation string, latency time.Duration, retryCount int, err error) *request.Request {
+	return &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceName: service},
+		Error:      err,
+		Operation:  &request.Operation{Name: operation},
+		RetryCount: retryCount,
+		Time:       time.Now().Add(-1 * latency),
+	}
+}
+
+func TestCollector(t *testing.T) {
+	collector := NewCollector()
+	handlers1 := request.Handlers{}
+	handlers2 := request.Handlers{}
+	collector.Install(&handlers1)
+	collector.Install(&handlers2)
+
+	throttle := testRequest("ec2", "DescribeVpcs", 0, 0, awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil))
+	handlers1.Retry.Run(throttle)
+	handlers1.Retry.Run(throttle)
+	handlers1.Retry.Run(testRequest("ec2", "DescribeVpcs", 0, 0, awserr.New("InvalidVpcID.NotFound", "", nil)))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			handlers2.Complete.Run(testRequest("ec2", "DescribeVpcs", 200*time.Millisecond, 1, nil))
+		}()
+	}
+
+	wg.Wait()
+
+	handlers1.Complete.Run(testRequest("route53", "ListHostedZones", 10*time.Millisecond, 0, awserr.New("AccessDenied", "", nil)))
+
+	summary := collector.Summary()
+
+	if got, expected := len(summary), 2; got != expected {
+		t.Fatalf("got %d operations, expected %d: %#v", got, expected, summary)
+	}
+
+	ec2 := summary[0]
+
+	if ec2.Service != "ec2" || ec2.Operation != "DescribeVpcs" {
+		t.Fatalf("expected ec2 DescribeVpcs first, got %#v", ec2)
+	}
+
+	if ec2.Calls != 10 || ec2.Errors != 0 || ec2.Throttles != 2 || ec2.Retries != 10 {
+		t.Errorf("unexpected ec2 DescribeVpcs metrics: %#v", ec2)
+	}
+
+	if ec2.LatencyMs < 2000 {
+		t.Errorf("got ec2 DescribeVpcs latency %dms, expected at least 2000ms", ec2.LatencyMs)
+	}
+
+	if route53 := summary[1]; route53.Calls != 1 || route53.Errors != 1 {
+		t.Errorf("unexpected route53 ListHostedZones metrics: %#v", route53)
+	}
+}
+
+func TestCollectorWriteSummary(t *testing.T) {
+	collector := NewCollector()
+	handlers := request.Handlers{}
+	collector.Install(&handlers)
+
+	t.Run("empty", func(t *testing.T) {
+		var lines []string
+		logf = func(format string, v ...interface{}) {
+			lines = append(lines, fmt.Sprintf(format, v...))
+		}
+		defer func() { logf = log.Printf }()
+
+		if err := collector.WriteSummary(""); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(lines) != 0 {
+			t.Errorf("expected no log lines, got %v", lines)
+		}
+	})
+
+	handlers.Complete.Run(testRequest("s3", "GetBucketPolicy", 0, 2, nil))
+
+	t.Run("log", func(t *testing.T) {
+		var lines []string
+		logf = func(format string, v ...interface{}) {
+			lines = append(lines, fmt.Sprintf(format, v...))
+		}
+		defer func() { logf = log.Printf }()
+
+		if err := collector.WriteSummary(""); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, expected := len(lines), 1; got != expected {
+			t.Fatalf("got %d log lines, expected %d", got, expected)
+		}
+
+		if expected := "service=s3 operation=GetBucketPolicy calls=1 errors=0 throttles=0 retries=2"; !strings.Contains(lines[0], expected) {
+			t.Errorf("expected %q in log line: %s", expected, lines[0])
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "apimetrics")
+
+		if err != nil {
+			t.Fatalf("error creating temporary directory: %s", err)
+		}
+
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "metrics.json")
+
+		if err := collector.WriteSummary(path); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		b, err := ioutil.ReadFile(path)
+
+		if err != nil {
+			t.Fatalf("error reading metrics file: %s", err)
+		}
+
+		var got []Metrics
+
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatalf("error decoding metrics file: %s", err)
+		}
+
+		expected := []Metrics{
+			{
+				Service:   "s3",
+				Operation: "GetBucketPolicy",
+				Calls:     1,
+				Retries:   2,
+			},
+		}
+
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("got %#v, expected %#v", got, expected)
+		}
+	})
+}
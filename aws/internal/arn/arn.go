@@ -0,0 +1,181 @@
+package arn
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	AccountIDRegexpInternalPattern = `(aws|\d{12})`
+	PartitionRegexpInternalPattern = `aws(-[a-z]+)*`
+	RegionRegexpInternalPattern    = `[a-z]{2}(-[a-z]+)+-\d`
+)
+
+const (
+	AccountIDRegexpPattern = "^" + AccountIDRegexpInternalPattern + "$"
+	PartitionRegexpPattern = "^" + PartitionRegexpInternalPattern + "$"
+	RegionRegexpPattern    = "^" + RegionRegexpInternalPattern + "$"
+)
+
+var accountIDRegexp = regexp.MustCompile(AccountIDRegexpPattern)
+var partitionRegexp = regexp.MustCompile(PartitionRegexpPattern)
+var regionRegexp = regexp.MustCompile(RegionRegexpPattern)
+
+// Partition returns the partition of the region, or the aws partition if the region is unknown.
+func Partition(region string) string {
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return partition.ID()
+	}
+
+	return endpoints.AwsPartitionID
+}
+
+// Validate returns the errors of an ARN which does not have the general ARN format:
+// a partition, optional region, optional account ID and resource.
+func Validate(s string) []error {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+
+	if parsedARN.Partition == "" {
+		errs = append(errs, fmt.Errorf("missing partition value"))
+	} else if !partitionRegexp.MatchString(parsedARN.Partition) {
+		errs = append(errs, fmt.Errorf("invalid partition value (expecting to match regular expression: %s)", PartitionRegexpPattern))
+	}
+
+	if parsedARN.Region != "" && !regionRegexp.MatchString(parsedARN.Region) {
+		errs = append(errs, fmt.Errorf("invalid region value (expecting to match regular expression: %s)", RegionRegexpPattern))
+	}
+
+	if parsedARN.AccountID != "" && !accountIDRegexp.MatchString(parsedARN.AccountID) {
+		errs = append(errs, fmt.Errorf("invalid account ID value (expecting to match regular expression: %s)", AccountIDRegexpPattern))
+	}
+
+	if parsedARN.Resource == "" {
+		errs = append(errs, fmt.Errorf("missing resource value"))
+	}
+
+	return errs
+}
+
+// ValidateFunc is a SchemaValidateFunc which checks an ARN has the general ARN format.
+// Empty values are allowed. See Validate.
+func ValidateFunc(v interface{}, k string) (ws []string, errors []error) {
+	return validate(v, k, Validate)
+}
+
+func validate(v interface{}, k string, validateFn func(string) []error) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return ws, errors
+	}
+
+	if value == "" {
+		return ws, errors
+	}
+
+	for _, err := range validateFn(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+	}
+
+	return ws, errors
+}
+
+// SplitResource splits the resource of an ARN into the resource type and the
+// resource path, using the first "/" or ":" as the separator, for example:
+//
+//   role/path/name        => role, [path name]
+//   function:name:version => function, [name version]
+//
+// If the resource contains neither, the resource type is empty and the resource
+// path is the resource.
+func SplitResource(resource string) (string, []string) {
+	i := strings.IndexAny(resource, "/:")
+
+	if i == -1 {
+		return "", []string{resource}
+	}
+
+	return resource[:i], strings.Split(resource[i+1:], resource[i:i+1])
+}
+
+// Match returns whether the ARN matches the pattern. Like the ArnLike condition
+// operator, each of the six sections of the pattern is matched separately and
+// may contain "*" (any characters) and "?" (any single character) wildcards.
+func Match(pattern string, s string) bool {
+	patternSections := strings.SplitN(pattern, ":", 6)
+	sections := strings.SplitN(s, ":", 6)
+
+	if len(patternSections) != 6 || len(sections) != 6 {
+		return false
+	}
+
+	for i := range sections {
+		if !wildcardMatch(patternSections[i], sections[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateFuncMatching returns a SchemaValidateFunc which checks an ARN has the
+// general ARN format and matches one of the patterns. See Match.
+func ValidateFuncMatching(patterns ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		return validate(v, k, func(s string) []error {
+			if errs := Validate(s); len(errs) > 0 {
+				return errs
+			}
+
+			for _, pattern := range patterns {
+				if Match(pattern, s) {
+					return nil
+				}
+			}
+
+			return []error{fmt.Errorf("expected to match one of %s", strings.Join(patterns, ", "))}
+		})
+	}
+}
+
+// wildcardMatch returns whether s matches the pattern containing "*" and "?" wildcards.
+func wildcardMatch(pattern string, s string) bool {
+	p, i := 0, 0
+	starP, starI := -1, 0
+
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			starP, starI = p, i
+			p++
+		case starP != -1:
+			// Backtrack, letting the last "*" match one more character
+			p = starP + 1
+			starI++
+			i = starI
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
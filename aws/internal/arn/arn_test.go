@@ -0,0 +1,202 @@
+package arn
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPartition(t *testing.T) {
+	testCases := []struct {
+		Region   string
+		Expected string
+	}{
+		{Region: "us-west-2", Expected: "aws"},
+		{Region: "cn-north-1", Expected: "aws-cn"},
+		{Region: "us-gov-west-1", Expected: "aws-us-gov"},
+		{Region: "us-iso-east-1", Expected: "aws-iso"},
+		{Region: "unknown", Expected: "aws"},
+		{Region: "", Expected: "aws"},
+	}
+
+	for _, testCase := range testCases {
+		if got := Partition(testCase.Region); got != testCase.Expected {
+			t.Errorf("Partition(%q) = %q, expected %q", testCase.Region, got, testCase.Expected)
+		}
+	}
+}
+
+func TestValidateFunc(t *testing.T) {
+	validNames := []string{
+		"",
+		"arn:aws:elasticbeanstalk:us-east-1:123456789012:environment/My App/MyEnvironment", // Beanstalk
+		"arn:aws:iam::123456789012:user/David",                                             // IAM User
+		"arn:aws:iam::aws:policy/CloudWatchReadOnlyAccess",                                 // Managed IAM policy
+		"arn:aws:rds:eu-west-1:123456789012:db:mysql-db",                                   // RDS
+		"arn:aws:s3:::my_corporate_bucket/exampleobject.png",                               // S3 object
+		"arn:aws:events:us-east-1:319201112229:rule/rule_name",                             // CloudWatch Rule
+		"arn:aws-us-gov:s3:::corp_bucket/object.png",                                       // GovCloud ARN
+		"arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-12345678",                       // China ARN
+	}
+
+	for _, v := range validNames {
+		if _, errors := ValidateFunc(v, "arn"); len(errors) != 0 {
+			t.Errorf("%q should be a valid ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"arn",
+		"123456789012",
+		"arn:aws",
+		"arn:aws:logs",
+		"arn:aws:logs:region:*:*",
+		"arn::iam::123456789012:user/David",
+		"arn:AWS:iam::123456789012:user/David",
+		"arn:aws:iam::1234:user/David",
+		"arn:aws:iam::123456789012:",
+	}
+
+	for _, v := range invalidNames {
+		if _, errors := ValidateFunc(v, "arn"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid ARN", v)
+		}
+	}
+}
+
+func TestSplitResource(t *testing.T) {
+	testCases := []struct {
+		Resource             string
+		ExpectedResourceType string
+		ExpectedResourcePath []string
+	}{
+		{
+			Resource:             "role/path/name",
+			ExpectedResourceType: "role",
+			ExpectedResourcePath: []string{"path", "name"},
+		},
+		{
+			Resource:             "function:name:version",
+			ExpectedResourceType: "function",
+			ExpectedResourcePath: []string{"name", "version"},
+		},
+		{
+			Resource:             "log-group:name:*",
+			ExpectedResourceType: "log-group",
+			ExpectedResourcePath: []string{"name", "*"},
+		},
+		{
+			Resource:             "bucket",
+			ExpectedResourceType: "",
+			ExpectedResourcePath: []string{"bucket"},
+		},
+		{
+			Resource:             "parameter/path/name",
+			ExpectedResourceType: "parameter",
+			ExpectedResourcePath: []string{"path", "name"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Resource, func(t *testing.T) {
+			resourceType, resourcePath := SplitResource(testCase.Resource)
+
+			if resourceType != testCase.ExpectedResourceType {
+				t.Errorf("expected resource type %q, got %q", testCase.ExpectedResourceType, resourceType)
+			}
+
+			if !reflect.DeepEqual(resourcePath, testCase.ExpectedResourcePath) {
+				t.Errorf("expected resource path %q, got %q", testCase.ExpectedResourcePath, resourcePath)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		ARN      string
+		Expected bool
+	}{
+		{
+			Pattern:  "arn:aws:iam::123456789012:role/name",
+			ARN:      "arn:aws:iam::123456789012:role/name",
+			Expected: true,
+		},
+		{
+			Pattern:  "arn:*:iam::*:role/*",
+			ARN:      "arn:aws-us-gov:iam::123456789012:role/path/name",
+			Expected: true,
+		},
+		{
+			Pattern:  "arn:*:iam::*:role/*",
+			ARN:      "arn:aws:iam::123456789012:user/name",
+			Expected: false,
+		},
+		{
+			// Wildcards do not match across sections
+			Pattern:  "arn:aws:*:123456789012:role/name",
+			ARN:      "arn:aws:iam::123456789012:role/name",
+			Expected: false,
+		},
+		{
+			Pattern:  "arn:aws:s3:::bucket-?",
+			ARN:      "arn:aws:s3:::bucket-1",
+			Expected: true,
+		},
+		{
+			Pattern:  "arn:aws:s3:::bucket-?",
+			ARN:      "arn:aws:s3:::bucket-10",
+			Expected: false,
+		},
+		{
+			// The resource section may contain ":"
+			Pattern:  "arn:aws:lambda:*:*:function:*",
+			ARN:      "arn:aws:lambda:us-west-2:123456789012:function:name:1",
+			Expected: true,
+		},
+		{
+			Pattern:  "arn:aws:logs:*:*:log-group:*:*",
+			ARN:      "arn:aws:logs:us-west-2:123456789012:log-group:name",
+			Expected: false,
+		},
+		{
+			Pattern:  "arn:aws:sqs:us-west-2:123456789012:*a*b*",
+			ARN:      "arn:aws:sqs:us-west-2:123456789012:xaybz",
+			Expected: true,
+		},
+		{
+			Pattern:  "*",
+			ARN:      "arn:aws:s3:::bucket",
+			Expected: false,
+		},
+		{
+			Pattern:  "arn:aws:s3:::bucket",
+			ARN:      "bucket",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Pattern+" "+testCase.ARN, func(t *testing.T) {
+			if got := Match(testCase.Pattern, testCase.ARN); got != testCase.Expected {
+				t.Errorf("expected %t, got %t", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateFuncMatching(t *testing.T) {
+	validateFunc := ValidateFuncMatching("arn:*:iam::*:role/*", "arn:*:iam::*:user/*")
+
+	for _, v := range []string{"", "arn:aws:iam::123456789012:role/name", "arn:aws:iam::123456789012:user/name"} {
+		if _, errors := validateFunc(v, "arn"); len(errors) != 0 {
+			t.Errorf("%q should be valid: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"arn:aws:iam::123456789012:group/name", "arn:aws:sts::123456789012:role/name", "role/name"} {
+		if _, errors := validateFunc(v, "arn"); len(errors) == 0 {
+			t.Errorf("%q should be invalid", v)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package arn
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceType describes the ARN format of a type of resource, for example
+// IAM roles: arn:PARTITION:iam::ACCOUNT:role/PATH/NAME
+type ResourceType struct {
+	// Service is the service namespace, e.g. iam. Required.
+	Service string
+
+	// Type is the resource type prefix of the resource, e.g. role.
+	// Optional, for resources which are only a name, e.g. S3 buckets.
+	Type string
+
+	// Separator separates the resource type and the resource path
+	// elements, "/" or ":". Optional, defaults to "/".
+	Separator string
+
+	// Global is whether the ARN has no region.
+	Global bool
+
+	// NoAccountID is whether the ARN has no account ID.
+	NoAccountID bool
+}
+
+// ResourceTypeARN is an ARN parsed according to its resource type.
+type ResourceTypeARN struct {
+	arn.ARN
+
+	// ResourcePath is the resource without the resource type, split by the separator.
+	ResourcePath []string
+}
+
+// New returns the ARN of a resource of the type. The region is ignored for global
+// resource types and the account ID for resource types without account IDs.
+// The resource path elements are joined with the separator.
+func (t ResourceType) New(partition, region, accountID string, path ...string) arn.ARN {
+	if t.Global {
+		region = ""
+	}
+
+	if t.NoAccountID {
+		accountID = ""
+	}
+
+	resource := strings.Join(path, t.separator())
+
+	if t.Type != "" {
+		resource = t.Type + t.separator() + resource
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   t.Service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resource,
+	}
+}
+
+// Parse parses an ARN of a resource of the type.
+func (t ResourceType) Parse(s string) (ResourceTypeARN, error) {
+	if errs := t.Validate(s); len(errs) > 0 {
+		return ResourceTypeARN{}, errs[0]
+	}
+
+	parsedARN, _ := arn.Parse(s)
+	resource := parsedARN.Resource
+
+	if t.Type != "" {
+		resource = strings.TrimPrefix(resource, t.Type+t.separator())
+	}
+
+	return ResourceTypeARN{
+		ARN:          parsedARN,
+		ResourcePath: strings.Split(resource, t.separator()),
+	}, nil
+}
+
+// String returns the ARN format of the resource type.
+func (t ResourceType) String() string {
+	region, accountID, resource := "REGION", "ACCOUNT", "NAME"
+
+	if t.Global {
+		region = ""
+	}
+
+	if t.NoAccountID {
+		accountID = ""
+	}
+
+	if t.Type != "" {
+		resource = t.Type + t.separator() + resource
+	}
+
+	return fmt.Sprintf("arn:PARTITION:%s:%s:%s:%s", t.Service, region, accountID, resource)
+}
+
+// Validate returns the errors of an ARN which does not have the general ARN format
+// or is not an ARN of a resource of the type.
+func (t ResourceType) Validate(s string) []error {
+	if errs := Validate(s); len(errs) > 0 {
+		return errs
+	}
+
+	parsedARN, _ := arn.Parse(s)
+
+	if parsedARN.Service != t.Service {
+		return []error{fmt.Errorf("expected service %s, got %s (expecting format %s)", t.Service, parsedARN.Service, t)}
+	}
+
+	var errs []error
+
+	if t.Global && parsedARN.Region != "" {
+		errs = append(errs, fmt.Errorf("unexpected region value (expecting format %s)", t))
+	} else if !t.Global && parsedARN.Region == "" {
+		errs = append(errs, fmt.Errorf("missing region value (expecting format %s)", t))
+	}
+
+	if t.NoAccountID && parsedARN.AccountID != "" {
+		errs = append(errs, fmt.Errorf("unexpected account ID value (expecting format %s)", t))
+	} else if !t.NoAccountID && parsedARN.AccountID == "" {
+		errs = append(errs, fmt.Errorf("missing account ID value (expecting format %s)", t))
+	}
+
+	if t.Type != "" {
+		if prefix := t.Type + t.separator(); !strings.HasPrefix(parsedARN.Resource, prefix) || parsedARN.Resource == prefix {
+			errs = append(errs, fmt.Errorf("expected resource type %s (expecting format %s)", t.Type, t))
+		}
+	}
+
+	return errs
+}
+
+// ValidateFunc returns a SchemaValidateFunc which checks an ARN is an ARN of a
+// resource of the type. Empty values are allowed. See Validate.
+func (t ResourceType) ValidateFunc() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		return validate(v, k, t.Validate)
+	}
+}
+
+func (t ResourceType) separator() string {
+	if t.Separator == "" {
+		return "/"
+	}
+
+	return t.Separator
+}
@@ -0,0 +1,188 @@
+package arn
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceTypeNew(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		ResourceType ResourceType
+		Partition    string
+		Region       string
+		AccountID    string
+		Path         []string
+		Expected     string
+	}{
+		{
+			Name:         "IAM role",
+			ResourceType: IAMRole,
+			Partition:    "aws",
+			Region:       "us-west-2",
+			AccountID:    "123456789012",
+			Path:         []string{"path", "name"},
+			Expected:     "arn:aws:iam::123456789012:role/path/name",
+		},
+		{
+			Name:         "Lambda function",
+			ResourceType: LambdaFunction,
+			Partition:    "aws-cn",
+			Region:       "cn-north-1",
+			AccountID:    "123456789012",
+			Path:         []string{"name", "1"},
+			Expected:     "arn:aws-cn:lambda:cn-north-1:123456789012:function:name:1",
+		},
+		{
+			Name:         "S3 bucket",
+			ResourceType: S3Bucket,
+			Partition:    "aws-us-gov",
+			Region:       "us-gov-west-1",
+			AccountID:    "123456789012",
+			Path:         []string{"bucket"},
+			Expected:     "arn:aws-us-gov:s3:::bucket",
+		},
+		{
+			Name:         "SQS queue",
+			ResourceType: SQSQueue,
+			Partition:    "aws",
+			Region:       "us-west-2",
+			AccountID:    "123456789012",
+			Path:         []string{"queue"},
+			Expected:     "arn:aws:sqs:us-west-2:123456789012:queue",
+		},
+		{
+			Name:         "SSM parameter",
+			ResourceType: SSMParameter,
+			Partition:    "aws",
+			Region:       "us-west-2",
+			AccountID:    "123456789012",
+			Path:         []string{"path", "name"},
+			Expected:     "arn:aws:ssm:us-west-2:123456789012:parameter/path/name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.ResourceType.New(testCase.Partition, testCase.Region, testCase.AccountID, testCase.Path...).String()
+
+			if got != testCase.Expected {
+				t.Errorf("expected %q, got %q", testCase.Expected, got)
+			}
+
+			if errs := testCase.ResourceType.Validate(got); len(errs) != 0 {
+				t.Errorf("expected %q to be valid: %q", got, errs)
+			}
+		})
+	}
+}
+
+func TestResourceTypeParse(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		ResourceType         ResourceType
+		ARN                  string
+		ExpectedAccountID    string
+		ExpectedResourcePath []string
+		ExpectError          bool
+	}{
+		{
+			Name:                 "IAM role",
+			ResourceType:         IAMRole,
+			ARN:                  "arn:aws:iam::123456789012:role/path/name",
+			ExpectedAccountID:    "123456789012",
+			ExpectedResourcePath: []string{"path", "name"},
+		},
+		{
+			Name:                 "Secrets Manager secret",
+			ResourceType:         SecretsManagerSecret,
+			ARN:                  "arn:aws:secretsmanager:us-west-2:123456789012:secret:name-AbCdEf",
+			ExpectedAccountID:    "123456789012",
+			ExpectedResourcePath: []string{"name-AbCdEf"},
+		},
+		{
+			Name:                 "S3 bucket",
+			ResourceType:         S3Bucket,
+			ARN:                  "arn:aws:s3:::bucket",
+			ExpectedResourcePath: []string{"bucket"},
+		},
+		{
+			Name:         "wrong resource type",
+			ResourceType: IAMRole,
+			ARN:          "arn:aws:iam::123456789012:user/name",
+			ExpectError:  true,
+		},
+		{
+			Name:         "invalid ARN",
+			ResourceType: IAMRole,
+			ARN:          "role/name",
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.ResourceType.Parse(testCase.ARN)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.AccountID != testCase.ExpectedAccountID {
+				t.Errorf("expected account ID %q, got %q", testCase.ExpectedAccountID, got.AccountID)
+			}
+
+			if !reflect.DeepEqual(got.ResourcePath, testCase.ExpectedResourcePath) {
+				t.Errorf("expected resource path %q, got %q", testCase.ExpectedResourcePath, got.ResourcePath)
+			}
+
+			if got.String() != testCase.ARN {
+				t.Errorf("expected %q, got %q", testCase.ARN, got.String())
+			}
+		})
+	}
+}
+
+func TestResourceTypeValidateFunc(t *testing.T) {
+	validateFunc := IAMRole.ValidateFunc()
+
+	validNames := []string{
+		"",
+		"arn:aws:iam::123456789012:role/name",
+		"arn:aws:iam::123456789012:role/path/name",
+		"arn:aws-us-gov:iam::123456789012:role/name",
+		"arn:aws-cn:iam::123456789012:role/service-role/name",
+	}
+
+	for _, v := range validNames {
+		if _, errors := validateFunc(v, "role_arn"); len(errors) != 0 {
+			t.Errorf("%q should be a valid IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"name",
+		"arn:aws:iam::123456789012:user/name",
+		"arn:aws:iam::123456789012:role/",
+		"arn:aws:iam::123456789012:role",
+		"arn:aws:iam::123456789012:instance-profile/name",
+		"arn:aws:iam:us-west-2:123456789012:role/name",
+		"arn:aws:iam:::role/name",
+		"arn:aws:sts::123456789012:assumed-role/name/session",
+		"arn:aws:lambda:us-west-2:123456789012:function:name",
+	}
+
+	for _, v := range invalidNames {
+		if _, errors := validateFunc(v, "role_arn"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid IAM role ARN", v)
+		}
+	}
+}
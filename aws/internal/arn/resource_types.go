@@ -0,0 +1,92 @@
+package arn
+
+// Resource types shared by resources and validators.
+// Add resource types here rather than building or parsing their ARNs by hand.
+var (
+	IAMGroup = ResourceType{
+		Service: "iam",
+		Type:    "group",
+		Global:  true,
+	}
+
+	IAMInstanceProfile = ResourceType{
+		Service: "iam",
+		Type:    "instance-profile",
+		Global:  true,
+	}
+
+	IAMPolicy = ResourceType{
+		Service: "iam",
+		Type:    "policy",
+		Global:  true,
+	}
+
+	IAMRole = ResourceType{
+		Service: "iam",
+		Type:    "role",
+		Global:  true,
+	}
+
+	IAMUser = ResourceType{
+		Service: "iam",
+		Type:    "user",
+		Global:  true,
+	}
+
+	KinesisStream = ResourceType{
+		Service: "kinesis",
+		Type:    "stream",
+	}
+
+	KMSAlias = ResourceType{
+		Service: "kms",
+		Type:    "alias",
+	}
+
+	KMSKey = ResourceType{
+		Service: "kms",
+		Type:    "key",
+	}
+
+	LambdaFunction = ResourceType{
+		Service:   "lambda",
+		Type:      "function",
+		Separator: ":",
+	}
+
+	LogsLogGroup = ResourceType{
+		Service:   "logs",
+		Type:      "log-group",
+		Separator: ":",
+	}
+
+	S3Bucket = ResourceType{
+		Service:     "s3",
+		Global:      true,
+		NoAccountID: true,
+	}
+
+	SecretsManagerSecret = ResourceType{
+		Service:   "secretsmanager",
+		Type:      "secret",
+		Separator: ":",
+	}
+
+	SNSTopic = ResourceType{
+		Service: "sns",
+	}
+
+	SQSQueue = ResourceType{
+		Service: "sqs",
+	}
+
+	SSMDocument = ResourceType{
+		Service: "ssm",
+		Type:    "document",
+	}
+
+	SSMParameter = ResourceType{
+		Service: "ssm",
+		Type:    "parameter",
+	}
+)
@@ -9,6 +9,7 @@ import (
 )
 
 // Semaphore can be used to limit concurrent executions. This can be used to work with resources with low quotas
+// in acceptance tests. To limit concurrent API requests made by the provider, use retrypolicy.ConcurrencyLimit.
 type Semaphore chan struct{}
 
 // InitializeSemaphore initializes a semaphore with a default capacity or overrides it using an environment variable
@@ -8,24 +8,28 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
-	"html/template"
 	"io/ioutil"
 	"log"
 	"os"
 	"sort"
 	"strings"
+	"text/template"
 
 	"golang.org/x/tools/go/packages"
 )
 
 const (
-	outputName = "list_pages_gen.go"
+	outputName     = "list_pages_gen.go"
+	testOutputName = "list_pages_gen_test.go"
 )
 
 var (
-	functionNames = flag.String("function", "", "comma-separated list of API List functions; required")
-	paginatorName = flag.String("paginator", "NextToken", "name of the pagination token field")
-	packageName   = flag.String("package", "", "override package name for generated code")
+	functionNames       = flag.String("function", "", "comma-separated list of API List functions; required")
+	paginatorName       = flag.String("paginator", "NextToken", "name of the pagination token field of both the input and output")
+	inputPaginatorName  = flag.String("input-paginator", "", "name of the input pagination token field, if different from the output (default the value of -paginator)")
+	outputPaginatorName = flag.String("output-paginator", "", "name of the output pagination token field, if different from the input (default the value of -paginator)")
+	truncatedName       = flag.String("truncated", "", "name of the output boolean field set when more results are available, e.g. IsTruncated")
+	packageName         = flag.String("package", "", "override package name for generated code")
 )
 
 func usage() {
@@ -54,41 +58,67 @@ func main() {
 	functions := strings.Split(*functionNames, ",")
 	sort.Strings(functions)
 
+	inputPaginator := *paginatorName
+	if *inputPaginatorName != "" {
+		inputPaginator = *inputPaginatorName
+	}
+	outputPaginator := *paginatorName
+	if *outputPaginatorName != "" {
+		outputPaginator = *outputPaginatorName
+	}
+
 	g := Generator{
-		paginator: *paginatorName,
-		tmpl:      template.Must(template.New("function").Parse(functionTemplate)),
+		inputPaginator:  inputPaginator,
+		outputPaginator: outputPaginator,
+		truncated:       *truncatedName,
+		tmpl:            template.Must(template.New("function").Parse(functionTemplate)),
 	}
 	g.parsePackage(sourcePackage)
 
-	g.printHeader(HeaderInfo{
+	headerInfo := HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: destinationPackage,
 		SourcePackage:      sourcePackage,
-	})
+		SourceName:         g.pkg.name,
+	}
 
-	for _, functionName := range functions {
-		g.generateFunction(functionName)
+	tg := Generator{
+		tmpl: template.Must(template.New("test").Parse(testTemplate)),
 	}
 
-	src := g.format()
+	g.printHeader(headerTemplate, headerInfo)
+	tg.printHeader(testHeaderTemplate, headerInfo)
+
+	for _, functionName := range functions {
+		funcSpec := g.generateFunction(functionName)
+		tg.execute(funcSpec)
+	}
 
-	err := ioutil.WriteFile(outputName, src, 0644)
+	err := ioutil.WriteFile(outputName, g.format(), 0644)
 	if err != nil {
 		log.Fatalf("error writing output: %s", err)
 	}
+
+	err = ioutil.WriteFile(testOutputName, tg.format(), 0644)
+	if err != nil {
+		log.Fatalf("error writing test output: %s", err)
+	}
 }
 
 type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
+	SourceName         string
 }
 
 type Generator struct {
-	buf       bytes.Buffer
-	pkg       *Package
-	tmpl      *template.Template
-	paginator string
+	buf             bytes.Buffer
+	pkg             *Package
+	tmpl            *template.Template
+	inputPaginator  string
+	outputPaginator string
+	truncated       string
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -104,8 +134,8 @@ type Package struct {
 	files []*PackageFile
 }
 
-func (g *Generator) printHeader(headerInfo HeaderInfo) {
-	header := template.Must(template.New("header").Parse(headerTemplate))
+func (g *Generator) printHeader(text string, headerInfo HeaderInfo) {
+	header := template.Must(template.New("header").Parse(text))
 	err := header.Execute(&g.buf, headerInfo)
 	if err != nil {
 		log.Fatalf("error writing header: %s", err)
@@ -140,14 +170,21 @@ func (g *Generator) addPackage(pkg *packages.Package) {
 }
 
 type FuncSpec struct {
-	Name       string
-	RecvType   string
-	ParamType  string
-	ResultType string
-	Paginator  string
+	Name            string
+	RecvType        string
+	ParamType       string
+	ResultType      string
+	InputPaginator  string
+	OutputPaginator string
+	Truncated       string
 }
 
-func (g *Generator) generateFunction(functionName string) {
+// InputType returns the input structure type, for use in composite literals.
+func (s FuncSpec) InputType() string {
+	return strings.TrimPrefix(s.ParamType, "*")
+}
+
+func (g *Generator) generateFunction(functionName string) FuncSpec {
 	var function *ast.FuncDecl
 
 	// TODO: check if a Pages() function has been defined
@@ -172,17 +209,100 @@ func (g *Generator) generateFunction(functionName string) {
 	}
 
 	funcSpec := FuncSpec{
-		Name:       function.Name.Name,
-		RecvType:   g.expandTypeField(function.Recv),
-		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
-		ResultType: g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
-		Paginator:  g.paginator,
+		Name:            function.Name.Name,
+		RecvType:        g.expandInterfaceType(function.Recv),
+		ParamType:       g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
+		ResultType:      g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
+		InputPaginator:  g.inputPaginator,
+		OutputPaginator: g.outputPaginator,
+		Truncated:       g.truncated,
+	}
+
+	g.checkField(function.Type.Params, funcSpec.InputPaginator, "string")
+	g.checkField(function.Type.Results, funcSpec.OutputPaginator, "string")
+	if funcSpec.Truncated != "" {
+		g.checkField(function.Type.Results, funcSpec.Truncated, "bool")
 	}
 
+	g.execute(funcSpec)
+
+	return funcSpec
+}
+
+func (g *Generator) execute(funcSpec FuncSpec) {
 	err := g.tmpl.Execute(&g.buf, funcSpec)
 	if err != nil {
-		log.Fatalf("error writing function \"%s\": %s", functionName, err)
+		log.Fatalf("error writing function \"%s\": %s", funcSpec.Name, err)
+	}
+}
+
+// expandInterfaceType returns the AWS Go SDK service interface type for a
+// service client receiver, e.g. wafiface.WAFAPI for *waf.WAF.
+func (g *Generator) expandInterfaceType(field *ast.FieldList) string {
+	typeValue := field.List[0].Type
+	if star, ok := typeValue.(*ast.StarExpr); ok {
+		if ident, ok := star.X.(*ast.Ident); ok {
+			return fmt.Sprintf("%[1]siface.%[2]sAPI", g.pkg.name, ident.Name)
+		}
+	}
+
+	log.Fatalf("Unexpected receiver type expression: (%[1]T) %[1]v", typeValue)
+	return ""
+}
+
+// checkField verifies that the structure type of the first field in the list
+// has a field with the given name, of a pointer to the given type.
+func (g *Generator) checkField(field *ast.FieldList, fieldName, fieldType string) {
+	typeName := g.expandTypeField(field)
+	structName := strings.TrimPrefix(typeName, fmt.Sprintf("*%s.", g.pkg.name))
+
+	structType := g.findStructType(structName)
+	if structType == nil {
+		log.Fatalf("structure type \"%s\" not found", structName)
+	}
+
+	for _, f := range structType.Fields.List {
+		for _, name := range f.Names {
+			if name.Name != fieldName {
+				continue
+			}
+
+			if star, ok := f.Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok && ident.Name == fieldType {
+					return
+				}
+			}
+
+			log.Fatalf("field \"%s\" of \"%s\" is not of type *%s", fieldName, structName, fieldType)
+		}
+	}
+
+	log.Fatalf("field \"%s\" not found in \"%s\"", fieldName, structName)
+}
+
+func (g *Generator) findStructType(name string) *ast.StructType {
+	for _, file := range g.pkg.files {
+		if file.file == nil {
+			continue
+		}
+
+		for _, decl := range file.file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok && typeSpec.Name.Name == name {
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+						return structType
+					}
+				}
+			}
+		}
 	}
+
+	return nil
 }
 
 func (g *Generator) expandTypeField(field *ast.FieldList) string {
@@ -213,6 +333,23 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"{{ .SourcePackage }}"
+	"{{ .SourcePackage }}/{{ .SourceName }}iface"
+)
+`
+
+const testHeaderTemplate = `// Code generated by "aws/internal/generators/listpages/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"{{ .SourcePackage }}"
+	"{{ .SourcePackage }}/{{ .SourceName }}iface"
 )
 `
 
@@ -229,17 +366,162 @@ func {{ .Name }}PagesWithContext(ctx context.Context, conn {{ .RecvType }}, inpu
 			return err
 		}
 
-		lastPage := aws.StringValue(output.{{ .Paginator }}) == ""
+		{{ if .Truncated -}}
+		lastPage := !aws.BoolValue(output.{{ .Truncated }}) || aws.StringValue(output.{{ .OutputPaginator }}) == ""
+		{{- else -}}
+		lastPage := aws.StringValue(output.{{ .OutputPaginator }}) == ""
+		{{- end }}
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
 
-		input.{{ .Paginator }} = output.{{ .Paginator }}
+		input.{{ .InputPaginator }} = output.{{ .OutputPaginator }}
 	}
 	return nil
 }
 `
 
+const testTemplate = `
+
+type fake{{ .Name }}Client struct {
+	{{ .RecvType }}
+
+	err     error
+	outputs []{{ .ResultType }}
+	tokens  []string
+}
+
+func (c *fake{{ .Name }}Client) {{ .Name }}WithContext(ctx aws.Context, input {{ .ParamType }}, opts ...request.Option) ({{ .ResultType }}, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.{{ .InputPaginator }}))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func Test{{ .Name }}Pages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []{{ .ResultType }}
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []{{ .ResultType }}{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []{{ .ResultType }}{
+				{
+					{{ .OutputPaginator }}: aws.String("token1"),
+					{{- if .Truncated }}
+					{{ .Truncated }}: aws.Bool(true),
+					{{- end }}
+				},
+				{
+					{{ .OutputPaginator }}: aws.String("token2"),
+					{{- if .Truncated }}
+					{{ .Truncated }}: aws.Bool(true),
+					{{- end }}
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []{{ .ResultType }}{
+				{
+					{{ .OutputPaginator }}: aws.String("token1"),
+					{{- if .Truncated }}
+					{{ .Truncated }}: aws.Bool(true),
+					{{- end }}
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{{- if .Truncated }}
+		{
+			Name: "not truncated",
+			Outputs: []{{ .ResultType }}{
+				{
+					{{ .OutputPaginator }}: aws.String("token1"),
+					{{ .Truncated }}: aws.Bool(false),
+				},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{{- end }}
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fake{{ .Name }}Client{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := {{ .Name }}Pages(conn, &{{ .InputType }}{}, func(page {{ .ResultType }}, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+`
+
 func (g *Generator) format() []byte {
 	src, err := format.Source(g.buf.Bytes())
 	if err != nil {
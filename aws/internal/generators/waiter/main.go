@@ -0,0 +1,761 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/imports"
+)
+
+const (
+	finderOutputName     = "finder_gen.go"
+	finderTestOutputName = "finder_gen_test.go"
+	statusOutputName     = "status_gen.go"
+	waiterOutputName     = "waiter_gen.go"
+	waiterTestOutputName = "waiter_gen_test.go"
+)
+
+var (
+	finderDirectory = flag.String("finder", "../finder", "directory of the finder package, relative to the waiter package")
+	packageName     = flag.String("package", "", "override package name for generated waiter code")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] <spec-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\tGenerates finder, status and waiter functions in the current (waiter) package and the finder package.\n")
+	fmt.Fprintf(os.Stderr, "\tDestination package is read from the environment variable $GOPACKAGE by default. Override it with the flag -package.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// Spec is the declarative specification read from the spec file.
+type Spec struct {
+	// Full Go package name of the AWS Go SDK service package,
+	// e.g. github.com/aws/aws-sdk-go/service/ec2.
+	SourcePackage string `json:"source_package"`
+
+	Resources []ResourceSpec `json:"resources"`
+}
+
+// ResourceSpec describes how to find a single resource and read its status.
+type ResourceSpec struct {
+	// Name of the resource, e.g. CarrierGateway.
+	Name string `json:"name"`
+
+	// AWS Go SDK function returning the resource, e.g. DescribeCarrierGateways.
+	Function string `json:"function"`
+
+	// Input field of Function set to the resource identifier, e.g. CarrierGatewayIds.
+	// Both *string and []*string fields are supported.
+	IDField string `json:"id_field"`
+
+	// Output field of Function containing the resource, e.g. CarrierGateways.
+	// Both a structure and a list of structures are supported.
+	OutputField string `json:"output_field"`
+
+	// Field of the resource containing its status, e.g. State.
+	StatusField string `json:"status_field"`
+
+	// AWS error codes and statuses which mean that the resource does not exist.
+	// Values may be the names of constants in the AWS Go SDK service package.
+	NotFoundErrorCodes []string `json:"not_found_error_codes"`
+	NotFoundStatuses   []string `json:"not_found_statuses"`
+
+	Waiters []WaiterSpec `json:"waiters"`
+}
+
+// WaiterSpec describes a single waiter for a resource.
+type WaiterSpec struct {
+	// Name of the waiter, appended to the resource name, e.g. Available.
+	Name string `json:"name"`
+
+	// Pending and target statuses. An empty target waits for the resource to
+	// not be found. Values may be the names of constants in the AWS Go SDK
+	// service package.
+	Pending []string `json:"pending"`
+	Target  []string `json:"target"`
+
+	// Maximum amount of time to wait, as a Go duration, e.g. 5m.
+	Timeout string `json:"timeout"`
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	destinationPackage := os.Getenv("GOPACKAGE")
+	if *packageName != "" {
+		destinationPackage = *packageName
+	}
+	args := flag.Args()
+	if len(args) == 0 || destinationPackage == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	specFile := args[0]
+
+	b, err := ioutil.ReadFile(specFile)
+	if err != nil {
+		log.Fatalf("error reading spec file: %s", err)
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(b, &spec); err != nil {
+		log.Fatalf("error parsing spec file (%s): %s", specFile, err)
+	}
+
+	if spec.SourcePackage == "" || len(spec.Resources) == 0 {
+		log.Fatalf("spec file (%s) must define source_package and resources", specFile)
+	}
+
+	g := Generator{}
+	g.parsePackage(spec.SourcePackage)
+
+	waiterPackagePath := g.packagePath(".")
+	finderPackagePath := path.Join(path.Dir(waiterPackagePath), filepath.ToSlash(filepath.Base(*finderDirectory)))
+
+	data := TemplateData{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: destinationPackage,
+		FinderPackage:      path.Base(finderPackagePath),
+		FinderPackagePath:  finderPackagePath,
+		SourcePackage:      spec.SourcePackage,
+		InterfacePackage:   fmt.Sprintf("%[1]s/%[2]siface", spec.SourcePackage, g.pkg.name),
+		InterfaceName:      fmt.Sprintf("%[1]siface.%[2]sAPI", g.pkg.name, g.clientTypeName()),
+	}
+
+	for _, resourceSpec := range spec.Resources {
+		data.Resources = append(data.Resources, g.expandResource(resourceSpec))
+	}
+
+	if err := os.MkdirAll(*finderDirectory, 0755); err != nil {
+		log.Fatalf("error creating finder package directory: %s", err)
+	}
+
+	g.writeFile(filepath.Join(*finderDirectory, finderOutputName), finderTemplate, data)
+	g.writeFile(filepath.Join(*finderDirectory, finderTestOutputName), finderTestTemplate, data)
+	g.writeFile(statusOutputName, statusTemplate, data)
+	g.writeFile(waiterOutputName, waiterTemplate, data)
+	g.writeFile(waiterTestOutputName, waiterTestTemplate, data)
+}
+
+type TemplateData struct {
+	Parameters         string
+	DestinationPackage string
+	FinderPackage      string
+	FinderPackagePath  string
+	SourcePackage      string
+	InterfacePackage   string
+	InterfaceName      string
+	Resources          []Resource
+}
+
+type Resource struct {
+	Name               string
+	Function           string
+	InputType          string
+	OutputType         string
+	IDField            string
+	IDFieldIsList      bool
+	OutputField        string
+	OutputFieldIsList  bool
+	ResourceType       string
+	StatusField        string
+	NotFoundErrorCodes []string
+	NotFoundStatuses   []string
+	Waiters            []Waiter
+}
+
+type Waiter struct {
+	Name    string
+	Pending []string
+	Target  []string
+	Timeout string
+}
+
+type Generator struct {
+	pkg *Package
+}
+
+type Package struct {
+	name      string
+	files     []*ast.File
+	constants map[string]bool
+	types     map[string]*ast.StructType
+}
+
+func (g *Generator) parsePackage(sourcePackage string) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	g.pkg = &Package{
+		name:      pkgs[0].Name,
+		files:     pkgs[0].Syntax,
+		constants: make(map[string]bool),
+		types:     make(map[string]*ast.StructType),
+	}
+
+	for _, file := range g.pkg.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				switch spec := spec.(type) {
+				case *ast.ValueSpec:
+					if genDecl.Tok != token.CONST {
+						continue
+					}
+					for _, name := range spec.Names {
+						g.pkg.constants[name.Name] = true
+					}
+				case *ast.TypeSpec:
+					if structType, ok := spec.Type.(*ast.StructType); ok {
+						g.pkg.types[spec.Name.Name] = structType
+					}
+				}
+			}
+		}
+	}
+}
+
+func (g *Generator) packagePath(pattern string) string {
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName}, pattern)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	return pkgs[0].PkgPath
+}
+
+// clientTypeName returns the name of the service client type returned by New().
+func (g *Generator) clientTypeName() string {
+	for _, file := range g.pkg.files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil || funcDecl.Name.Name != "New" || funcDecl.Type.Results == nil {
+				continue
+			}
+
+			if star, ok := funcDecl.Type.Results.List[0].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok {
+					return ident.Name
+				}
+			}
+		}
+	}
+
+	log.Fatalf("function \"New\" not found in package %s", g.pkg.name)
+	return ""
+}
+
+func (g *Generator) expandResource(spec ResourceSpec) Resource {
+	if spec.Name == "" || spec.Function == "" || spec.IDField == "" || spec.OutputField == "" || spec.StatusField == "" {
+		log.Fatalf("resource %q: name, function, id_field, output_field and status_field are required", spec.Name)
+	}
+
+	resource := Resource{
+		Name:        spec.Name,
+		Function:    spec.Function,
+		InputType:   fmt.Sprintf("%s.%sInput", g.pkg.name, spec.Function),
+		OutputType:  fmt.Sprintf("%s.%sOutput", g.pkg.name, spec.Function),
+		IDField:     spec.IDField,
+		OutputField: spec.OutputField,
+		StatusField: spec.StatusField,
+	}
+
+	idFieldType := g.fieldType(spec.Function+"Input", spec.IDField)
+	switch {
+	case isStarIdent(idFieldType, "string"):
+	case isArrayOf(idFieldType, func(expr ast.Expr) bool { return isStarIdent(expr, "string") }):
+		resource.IDFieldIsList = true
+	default:
+		log.Fatalf("resource %q: input field %s must be *string or []*string", spec.Name, spec.IDField)
+	}
+
+	outputFieldType := g.fieldType(spec.Function+"Output", spec.OutputField)
+	if array, ok := outputFieldType.(*ast.ArrayType); ok {
+		resource.OutputFieldIsList = true
+		outputFieldType = array.Elt
+	}
+	resourceTypeName := starIdentName(outputFieldType)
+	if resourceTypeName == "" {
+		log.Fatalf("resource %q: output field %s must be a structure or a list of structures", spec.Name, spec.OutputField)
+	}
+	resource.ResourceType = fmt.Sprintf("%s.%s", g.pkg.name, resourceTypeName)
+
+	if !isStarIdent(g.fieldType(resourceTypeName, spec.StatusField), "string") {
+		log.Fatalf("resource %q: status field %s must be *string", spec.Name, spec.StatusField)
+	}
+
+	resource.NotFoundErrorCodes = g.expandValues(spec.NotFoundErrorCodes)
+	resource.NotFoundStatuses = g.expandValues(spec.NotFoundStatuses)
+
+	for _, waiterSpec := range spec.Waiters {
+		if waiterSpec.Name == "" || len(waiterSpec.Pending) == 0 {
+			log.Fatalf("resource %q: waiter name and pending are required", spec.Name)
+		}
+
+		timeout, err := time.ParseDuration(waiterSpec.Timeout)
+		if err != nil || timeout <= 0 {
+			log.Fatalf("resource %q: waiter %q: invalid timeout %q", spec.Name, waiterSpec.Name, waiterSpec.Timeout)
+		}
+
+		resource.Waiters = append(resource.Waiters, Waiter{
+			Name:    waiterSpec.Name,
+			Pending: g.expandValues(waiterSpec.Pending),
+			Target:  g.expandValues(waiterSpec.Target),
+			Timeout: durationExpr(timeout),
+		})
+	}
+
+	return resource
+}
+
+// fieldType returns the type expression of a field in a structure of the source package.
+func (g *Generator) fieldType(typeName, fieldName string) ast.Expr {
+	structType, ok := g.pkg.types[typeName]
+	if !ok {
+		log.Fatalf("type \"%s\" not found in package %s", typeName, g.pkg.name)
+	}
+
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				return field.Type
+			}
+		}
+	}
+
+	log.Fatalf("field \"%s\" not found in type %s.%s", fieldName, g.pkg.name, typeName)
+	return nil
+}
+
+// expandValues returns Go expressions for spec values, which are either the names
+// of constants in the source package or string literals.
+func (g *Generator) expandValues(values []string) []string {
+	expressions := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if g.pkg.constants[value] {
+			expressions = append(expressions, fmt.Sprintf("%s.%s", g.pkg.name, value))
+		} else {
+			expressions = append(expressions, strconv.Quote(value))
+		}
+	}
+
+	return expressions
+}
+
+func (g *Generator) writeFile(filename, text string, data TemplateData) {
+	tmpl := template.Must(template.New(filename).Funcs(template.FuncMap{
+		"join":      strings.Join,
+		"lowerName": lowerName,
+	}).Parse(text))
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Fatalf("error generating %s: %s", filename, err)
+	}
+
+	// Unused imports, e.g. of tfawserr when no resource has not found error codes, are removed.
+	src, err := imports.Process(filename, buf.Bytes(), nil)
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	if err := ioutil.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+func isStarIdent(expr ast.Expr, name string) bool {
+	return starIdentName(expr) == name
+}
+
+func starIdentName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		if ident, ok := star.X.(*ast.Ident); ok {
+			return ident.Name
+		}
+	}
+
+	return ""
+}
+
+func isArrayOf(expr ast.Expr, elem func(ast.Expr) bool) bool {
+	array, ok := expr.(*ast.ArrayType)
+	return ok && array.Len == nil && elem(array.Elt)
+}
+
+// durationExpr returns a Go expression for the duration, e.g. 5 * time.Minute.
+func durationExpr(d time.Duration) string {
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	}
+
+	return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+}
+
+// lowerName returns the name with its first letter in lower case, e.g. carrierGateway.
+func lowerName(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+const finderTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .FinderPackage }}
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+	"{{ .InterfacePackage }}"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+{{ range .Resources }}
+// {{ .Name }}ByID returns the {{ .Name }} corresponding to the specified identifier.
+// Returns nil and no error if the {{ .Name }} is not found.
+func {{ .Name }}ByID(conn {{ $.InterfaceName }}, id string) (*{{ .ResourceType }}, error) {
+	input := &{{ .InputType }}{
+		{{- if .IDFieldIsList }}
+		{{ .IDField }}: aws.StringSlice([]string{id}),
+		{{- else }}
+		{{ .IDField }}: aws.String(id),
+		{{- end }}
+	}
+
+	output, err := conn.{{ .Function }}(input)
+	{{- range .NotFoundErrorCodes }}
+
+	if tfawserr.ErrCodeEquals(err, {{ . }}) {
+		return nil, nil
+	}
+	{{- end }}
+
+	if err != nil {
+		return nil, err
+	}
+	{{- if .OutputFieldIsList }}
+
+	if output == nil || len(output.{{ .OutputField }}) == 0 {
+		return nil, nil
+	}
+
+	return output.{{ .OutputField }}[0], nil
+	{{- else }}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.{{ .OutputField }}, nil
+	{{- end }}
+}
+{{ end }}`
+
+const statusTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+	"{{ .InterfacePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"{{ .FinderPackagePath }}"
+)
+{{ range .Resources }}
+const (
+	{{ .Name }}StatusNotFound = "NotFound"
+	{{ .Name }}StatusUnknown  = "Unknown"
+)
+
+// {{ .Name }}{{ .StatusField }} fetches the {{ .Name }} and its {{ .StatusField }}
+func {{ .Name }}{{ .StatusField }}(conn {{ $.InterfaceName }}, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := {{ $.FinderPackage }}.{{ .Name }}ByID(conn, id)
+
+		if err != nil {
+			return nil, {{ .Name }}StatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, {{ .Name }}StatusNotFound, nil
+		}
+
+		status := aws.StringValue(output.{{ .StatusField }})
+		{{- if .NotFoundStatuses }}
+
+		switch status {
+		case {{ join .NotFoundStatuses ", " }}:
+			return nil, {{ .Name }}StatusNotFound, nil
+		}
+		{{- end }}
+
+		return output, status, nil
+	}
+}
+{{ end }}`
+
+const waiterTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"time"
+
+	"{{ .SourcePackage }}"
+	"{{ .InterfacePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+{{ range $resource := .Resources }}{{ range .Waiters }}
+const (
+	// Maximum amount of time to wait for a {{ $resource.Name }} to return {{ .Name }}
+	{{ $resource.Name }}{{ .Name }}Timeout = {{ .Timeout }}
+)
+
+// {{ $resource.Name }}{{ .Name }} waits for a {{ $resource.Name }} to return {{ .Name }}
+func {{ $resource.Name }}{{ .Name }}(conn {{ $.InterfaceName }}, id string) (*{{ $resource.ResourceType }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- join .Pending ", " -}} },
+		Target:  []string{ {{- join .Target ", " -}} },
+		Refresh: {{ $resource.Name }}{{ $resource.StatusField }}(conn, id),
+		Timeout: {{ $resource.Name }}{{ .Name }}Timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*{{ $resource.ResourceType }}); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+{{ end }}{{ end }}`
+
+const finderTestTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .FinderPackage }}
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"{{ .SourcePackage }}"
+	"{{ .InterfacePackage }}"
+)
+{{ range $resource := .Resources }}
+type mock{{ .Name }}Conn struct {
+	{{ $.InterfaceName }}
+
+	output *{{ .OutputType }}
+	err    error
+}
+
+func (m *mock{{ .Name }}Conn) {{ .Function }}(input *{{ .InputType }}) (*{{ .OutputType }}, error) {
+	return m.output, m.err
+}
+
+func Test{{ .Name }}ByID(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Conn          *mock{{ .Name }}Conn
+		ExpectedFound bool
+		ExpectedError bool
+	}{
+		{
+			Name: "found",
+			Conn: &mock{{ .Name }}Conn{
+				output: &{{ .OutputType }}{
+					{{- if .OutputFieldIsList }}
+					{{ .OutputField }}: []*{{ .ResourceType }}{ {{- "{" -}} {{ .StatusField }}: aws.String("test") {{- "}" -}} },
+					{{- else }}
+					{{ .OutputField }}: &{{ .ResourceType }}{ {{- .StatusField }}: aws.String("test") {{- "}" -}},
+					{{- end }}
+				},
+			},
+			ExpectedFound: true,
+		},
+		{
+			Name: "empty output",
+			Conn: &mock{{ .Name }}Conn{
+				output: &{{ .OutputType }}{},
+			},
+		},
+		{{- range .NotFoundErrorCodes }}
+		{
+			Name: "not found error " + {{ . }},
+			Conn: &mock{{ $resource.Name }}Conn{
+				err: awserr.New({{ . }}, "test", nil),
+			},
+		},
+		{{- end }}
+		{
+			Name: "error",
+			Conn: &mock{{ .Name }}Conn{
+				err: awserr.New("TestException", "test", nil),
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			output, err := {{ .Name }}ByID(testCase.Conn, "test-id")
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := output != nil; got != testCase.ExpectedFound {
+				t.Errorf("got found %t, expected %t", got, testCase.ExpectedFound)
+			}
+		})
+	}
+}
+{{ end }}`
+
+const waiterTestTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"{{ .SourcePackage }}"
+	"{{ .InterfacePackage }}"
+)
+{{ range $resource := .Resources }}
+type mock{{ .Name }}Conn struct {
+	{{ $.InterfaceName }}
+
+	status *string
+	err    error
+}
+
+func (m *mock{{ .Name }}Conn) {{ .Function }}(input *{{ .InputType }}) (*{{ .OutputType }}, error) {
+	if m.err != nil || m.status == nil {
+		return &{{ .OutputType }}{}, m.err
+	}
+
+	return &{{ .OutputType }}{
+		{{- if .OutputFieldIsList }}
+		{{ .OutputField }}: []*{{ .ResourceType }}{ {{- "{" -}} {{ .StatusField }}: m.status {{- "}" -}} },
+		{{- else }}
+		{{ .OutputField }}: &{{ .ResourceType }}{ {{- .StatusField }}: m.status {{- "}" -}},
+		{{- end }}
+	}, nil
+}
+
+func Test{{ .Name }}{{ .StatusField }}(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Conn           *mock{{ .Name }}Conn
+		ExpectedStatus string
+		ExpectedError  bool
+	}{
+		{
+			Name:           "status",
+			Conn:           &mock{{ .Name }}Conn{status: aws.String("test")},
+			ExpectedStatus: "test",
+		},
+		{
+			Name:           "not found",
+			Conn:           &mock{{ .Name }}Conn{},
+			ExpectedStatus: {{ .Name }}StatusNotFound,
+		},
+		{{- range .NotFoundStatuses }}
+		{
+			Name:           "not found status " + {{ . }},
+			Conn:           &mock{{ $resource.Name }}Conn{status: aws.String({{ . }})},
+			ExpectedStatus: {{ $resource.Name }}StatusNotFound,
+		},
+		{{- end }}
+		{
+			Name:           "error",
+			Conn:           &mock{{ .Name }}Conn{err: awserr.New("TestException", "test", nil)},
+			ExpectedStatus: {{ .Name }}StatusUnknown,
+			ExpectedError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, status, err := {{ .Name }}{{ .StatusField }}(testCase.Conn, "test-id")()
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if status != testCase.ExpectedStatus {
+				t.Errorf("got status %s, expected %s", status, testCase.ExpectedStatus)
+			}
+		})
+	}
+}
+{{ range .Waiters }}
+func Test{{ $resource.Name }}{{ .Name }}(t *testing.T) {
+	{{- if .Target }}
+	conn := &mock{{ $resource.Name }}Conn{status: aws.String({{ index .Target 0 }})}
+	{{- else }}
+	conn := &mock{{ $resource.Name }}Conn{}
+	{{- end }}
+
+	if _, err := {{ $resource.Name }}{{ .Name }}(conn, "test-id"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+{{ end }}{{ end }}`
@@ -222,7 +222,12 @@ func {{ . | Title }}UpdateTags(conn {{ . | ClientType }}, identifier string{{ if
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 {{- end }}
+
+	if err := newTags.IgnoreAws().Validate("{{ . }}"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
 	{{- if eq (. | TagFunction) (. | UntagFunction) }}
+
 	removedTags := oldTags.Removed(newTags)
 	updatedTags := oldTags.Updated(newTags)
 
@@ -17,6 +17,10 @@ func IamRoleUpdateTags(conn *iam.IAM, identifier string, oldTagsMap interface{},
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("iam"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &iam.UntagRoleInput{
 			RoleName: aws.String(identifier),
@@ -52,6 +56,10 @@ func IamUserUpdateTags(conn *iam.IAM, identifier string, oldTagsMap interface{},
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("iam"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &iam.UntagUserInput{
 			UserName: aws.String(identifier),
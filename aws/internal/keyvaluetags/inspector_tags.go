@@ -17,6 +17,10 @@ func InspectorUpdateTags(conn *inspector.Inspector, identifier string, oldTagsMa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("inspector"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if len(newTags) > 0 {
 		input := &inspector.SetTagsForResourceInput{
 			ResourceArn: aws.String(identifier),
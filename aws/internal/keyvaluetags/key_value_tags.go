@@ -13,7 +13,9 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 )
 
@@ -25,12 +27,53 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+const (
+	// TagKeyMaxLength is the maximum length of a tag key, in Unicode characters.
+	TagKeyMaxLength = 128
+
+	// TagValueMaxLength is the maximum length of a tag value, in Unicode characters.
+	TagValueMaxLength = 256
+)
+
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
 }
 
+// MergeTags returns the result of keyvaluetags.Merge() on the given
+// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
+// Merge returns an IgnoreConfig with the keys and key prefixes of both
+// the IgnoreConfig and the IgnoreConfig provided as an argument.
+func (ic *IgnoreConfig) Merge(mergeConfig *IgnoreConfig) *IgnoreConfig {
+	if ic == nil {
+		return mergeConfig
+	}
+
+	if mergeConfig == nil {
+		return ic
+	}
+
+	return &IgnoreConfig{
+		Keys:        ic.Keys.Merge(mergeConfig.Keys),
+		KeyPrefixes: ic.KeyPrefixes.Merge(mergeConfig.KeyPrefixes),
+	}
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -147,6 +190,24 @@ func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfig returns tags not present in a DefaultConfig object
+// in addition to tags with key/value pairs that override those in a DefaultConfig.
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -384,6 +445,44 @@ func (tags KeyValueTags) ContainsAll(target KeyValueTags) bool {
 	return true
 }
 
+// Validate returns an error if any tags would be rejected by the service.
+// Tag keys and values are checked against the limits common to all services,
+// and the number of tags against any service limit from ServiceTagMaxCount().
+// An empty service name checks only the common limits.
+func (tags KeyValueTags) Validate(serviceName string) error {
+	var errs *multierror.Error
+
+	if maxCount := ServiceTagMaxCount(serviceName); maxCount > 0 && len(tags) > maxCount {
+		errs = multierror.Append(errs, fmt.Errorf("%d tags exceeds the maximum of %d tags per resource", len(tags), maxCount))
+	}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if k == "" {
+			errs = multierror.Append(errs, fmt.Errorf("tag key cannot be empty"))
+			continue
+		}
+
+		if strings.HasPrefix(k, AwsTagKeyPrefix) {
+			errs = multierror.Append(errs, fmt.Errorf("tag key (%s) cannot begin with the reserved prefix %q", k, AwsTagKeyPrefix))
+		}
+
+		if n := utf8.RuneCountInString(k); n > TagKeyMaxLength {
+			errs = multierror.Append(errs, fmt.Errorf("tag key (%s) is %d characters, exceeding the maximum of %d", k, n, TagKeyMaxLength))
+		}
+
+		if v := tags.KeyValue(k); v != nil {
+			if n := utf8.RuneCountInString(*v); n > TagValueMaxLength {
+				errs = multierror.Append(errs, fmt.Errorf("tag (%s) value is %d characters, exceeding the maximum of %d", k, n, TagValueMaxLength))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // Hash returns a stable hash value.
 // The returned value may be negative (i.e. not suitable for a 'Set' function).
 func (tags KeyValueTags) Hash() int {
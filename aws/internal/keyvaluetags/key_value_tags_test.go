@@ -1,9 +1,144 @@
 package keyvaluetags
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+
+	multierror "github.com/hashicorp/go-multierror"
 )
 
+func TestDefaultConfigMergeTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "nil default config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no overlap",
+			tags: New(map[string]string{
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "resource tag overrides default",
+			tags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.MergeTags(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestIgnoreConfigMerge(t *testing.T) {
+	testCases := []struct {
+		name            string
+		ignoreConfig    *IgnoreConfig
+		mergeConfig     *IgnoreConfig
+		wantKeys        []string
+		wantKeyPrefixes []string
+	}{
+		{
+			name: "nil configs",
+		},
+		{
+			name: "nil merge config",
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key1"}),
+				KeyPrefixes: New([]string{"prefix1"}),
+			},
+			wantKeys:        []string{"key1"},
+			wantKeyPrefixes: []string{"prefix1"},
+		},
+		{
+			name: "nil ignore config",
+			mergeConfig: &IgnoreConfig{
+				Keys: New([]string{"key1"}),
+			},
+			wantKeys: []string{"key1"},
+		},
+		{
+			name: "both configs",
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key1", "key2"}),
+				KeyPrefixes: New([]string{"prefix1"}),
+			},
+			mergeConfig: &IgnoreConfig{
+				Keys:        New([]string{"key2", "key3"}),
+				KeyPrefixes: New([]string{"prefix2"}),
+			},
+			wantKeys:        []string{"key1", "key2", "key3"},
+			wantKeyPrefixes: []string{"prefix1", "prefix2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.ignoreConfig.Merge(testCase.mergeConfig)
+
+			if got == nil {
+				if len(testCase.wantKeys) > 0 || len(testCase.wantKeyPrefixes) > 0 {
+					t.Fatal("expected IgnoreConfig")
+				}
+
+				return
+			}
+
+			testKeyValueTagsVerifyKeys(t, got.Keys.Keys(), testCase.wantKeys)
+			testKeyValueTagsVerifyKeys(t, got.KeyPrefixes.Keys(), testCase.wantKeyPrefixes)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -458,6 +593,79 @@ func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "nil default config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "all defaults",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "overridden default value",
+			tags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnore(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1537,6 +1745,122 @@ func TestKeyValueTagsContainsAll(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsValidate(t *testing.T) {
+	manyTags := make(map[string]string)
+
+	for i := 0; i < 51; i++ {
+		manyTags[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	testCases := []struct {
+		name        string
+		tags        KeyValueTags
+		serviceName string
+		wantErrs    int
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+		},
+		{
+			name: "valid",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "",
+			}),
+			serviceName: "ec2",
+		},
+		{
+			name: "nil value",
+			tags: New(map[string]*string{
+				"key1": nil,
+			}),
+		},
+		{
+			name: "empty key",
+			tags: New(map[string]string{
+				"": "value1",
+			}),
+			wantErrs: 1,
+		},
+		{
+			name: "aws prefix",
+			tags: New(map[string]string{
+				"aws:cloudformation:stack-name": "value1",
+			}),
+			wantErrs: 1,
+		},
+		{
+			name: "maximum lengths",
+			tags: New(map[string]string{
+				strings.Repeat("k", TagKeyMaxLength): strings.Repeat("v", TagValueMaxLength),
+			}),
+		},
+		{
+			name: "multi-byte maximum lengths",
+			tags: New(map[string]string{
+				strings.Repeat("ü", TagKeyMaxLength): strings.Repeat("ü", TagValueMaxLength),
+			}),
+		},
+		{
+			name: "key too long",
+			tags: New(map[string]string{
+				strings.Repeat("k", TagKeyMaxLength+1): "value1",
+			}),
+			wantErrs: 1,
+		},
+		{
+			name: "value too long",
+			tags: New(map[string]string{
+				"key1": strings.Repeat("v", TagValueMaxLength+1),
+			}),
+			wantErrs: 1,
+		},
+		{
+			name: "multiple errors",
+			tags: New(map[string]string{
+				"aws:key1": "value1",
+				"key2":     strings.Repeat("v", TagValueMaxLength+1),
+			}),
+			wantErrs: 2,
+		},
+		{
+			name:        "too many tags",
+			tags:        New(manyTags),
+			serviceName: "ec2",
+			wantErrs:    1,
+		},
+		{
+			name: "too many tags unknown service",
+			tags: New(manyTags),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.tags.Validate(testCase.serviceName)
+
+			if testCase.wantErrs == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			merr, ok := err.(*multierror.Error)
+
+			if !ok {
+				t.Fatalf("expected *multierror.Error, got: %#v", err)
+			}
+
+			if got, want := len(merr.Errors), testCase.wantErrs; got != want {
+				t.Errorf("got %d errors, expected %d: %s", got, want, err)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsHash(t *testing.T) {
 	testCases := []struct {
 		name string
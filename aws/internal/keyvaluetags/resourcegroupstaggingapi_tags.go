@@ -0,0 +1,118 @@
+// +build !generate
+
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+// Custom Resource Groups Tagging API tag service functions using the same format as generated code.
+
+// ResourcegroupstaggingapiListTags lists resource tags.
+// The identifier is the Amazon Resource Name (ARN).
+// The API cannot look up a single resource, so all tagged resources of the ARN's service are searched.
+// Resources which have never been tagged are not returned by the API, so have no tags.
+func ResourcegroupstaggingapiListTags(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, identifier string) (KeyValueTags, error) {
+	resourceARN, err := arn.Parse(identifier)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{resourceARN.Service}),
+	}
+
+	tags := New(nil)
+
+	err = conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, mapping := range page.ResourceTagMappingList {
+			if mapping == nil || aws.StringValue(mapping.ResourceARN) != identifier {
+				continue
+			}
+
+			tags = ResourcegroupstaggingapiKeyValueTags(mapping.Tags)
+
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return tags, nil
+}
+
+// ResourcegroupstaggingapiUpdateTags updates resource tags.
+// The identifier is the Amazon Resource Name (ARN).
+func ResourcegroupstaggingapiUpdateTags(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if err := newTags.IgnoreAws().Validate("resourcegroupstaggingapi"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: aws.StringSlice([]string{identifier}),
+			TagKeys:         aws.StringSlice(removedTags.IgnoreAws().Keys()),
+		}
+
+		output, err := conn.UntagResources(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+
+		if output != nil {
+			if err := resourcegroupstaggingapiFailedResourcesError(output.FailedResourcesMap, identifier); err != nil {
+				return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+			}
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: aws.StringSlice([]string{identifier}),
+			Tags:            aws.StringMap(updatedTags.IgnoreAws().Map()),
+		}
+
+		output, err := conn.TagResources(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+
+		if output != nil {
+			if err := resourcegroupstaggingapiFailedResourcesError(output.FailedResourcesMap, identifier); err != nil {
+				return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourcegroupstaggingapiFailedResourcesError returns an error if the resource failed to be tagged or untagged.
+// The TagResources and UntagResources APIs report failures for each resource instead of returning an error.
+func resourcegroupstaggingapiFailedResourcesError(failedResources map[string]*resourcegroupstaggingapi.FailureInfo, identifier string) error {
+	failureInfo, ok := failedResources[identifier]
+
+	if !ok || failureInfo == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.StringValue(failureInfo.ErrorCode), aws.StringValue(failureInfo.ErrorMessage))
+}
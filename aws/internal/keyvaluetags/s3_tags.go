@@ -42,6 +42,10 @@ func S3BucketUpdateTags(conn *s3.S3, identifier string, oldTagsMap interface{},
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("s3"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	// We need to also consider any existing ignored tags.
 	allTags, err := S3BucketListTags(conn, identifier)
 
@@ -104,6 +108,10 @@ func S3ObjectUpdateTags(conn *s3.S3, bucket, key string, oldTagsMap interface{},
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("s3"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s/%s): %w", bucket, key, err)
+	}
+
 	// We need to also consider any existing ignored tags.
 	allTags, err := S3ObjectListTags(conn, bucket, key)
 
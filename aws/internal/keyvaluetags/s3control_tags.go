@@ -52,6 +52,10 @@ func S3controlBucketUpdateTags(conn *s3control.S3Control, identifier string, old
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("s3control"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	// We need to also consider any existing ignored tags.
 	allTags, err := S3controlBucketListTags(conn, identifier)
 
@@ -628,6 +628,63 @@ func ServiceTagFunction(serviceName string) string {
 	}
 }
 
+// ServiceTagMaxCount determines the maximum number of tags per resource.
+// Returns 0 if the limit is not known, in which case the number of tags is not validated.
+func ServiceTagMaxCount(serviceName string) int {
+	switch serviceName {
+	case "acm":
+		return 50
+	case "apigateway":
+		return 50
+	case "autoscaling":
+		return 50
+	case "cloudfront":
+		return 50
+	case "cloudwatch":
+		return 50
+	case "cloudwatchlogs":
+		return 50
+	case "dynamodb":
+		return 50
+	case "ec2":
+		return 50
+	case "ecr":
+		return 50
+	case "ecs":
+		return 50
+	case "eks":
+		return 50
+	case "elasticache":
+		return 50
+	case "elb":
+		return 50
+	case "elbv2":
+		return 50
+	case "iam":
+		return 50
+	case "kinesis":
+		return 50
+	case "kms":
+		return 50
+	case "lambda":
+		return 50
+	case "rds":
+		return 50
+	case "redshift":
+		return 50
+	case "secretsmanager":
+		return 50
+	case "sns":
+		return 50
+	case "sqs":
+		return 50
+	case "ssm":
+		return 50
+	default:
+		return 0
+	}
+}
+
 // ServiceTagFunctionBatchSize determines the batch size (if any) for tagging and untagging.
 func ServiceTagFunctionBatchSize(serviceName string) string {
 	switch serviceName {
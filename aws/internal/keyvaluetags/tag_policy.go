@@ -0,0 +1,171 @@
+package keyvaluetags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	tagPolicyAssignOperator = "@@assign"
+	tagPolicyValueWildcard  = "*"
+)
+
+// TagPolicyConfig contains a tag policy to check resource tags against.
+type TagPolicyConfig struct {
+	Policy       *TagPolicy
+	RequiredKeys KeyValueTags
+}
+
+// TagPolicy is an AWS Organizations tag policy.
+// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html
+type TagPolicy struct {
+	// Tags contains the policy for each tag, by lowercase tag key.
+	Tags map[string]*TagPolicyTag
+}
+
+// TagPolicyTag is the policy for a single tag.
+type TagPolicyTag struct {
+	// Key is the required capitalization of the tag key, if any.
+	Key string
+
+	// Values are the allowed tag values, if any.
+	// A value may contain a single "*" wildcard.
+	Values []string
+}
+
+// NewTagPolicy parses the JSON content of a tag policy.
+// Both policies using the "@@assign" inheritance operator and
+// the effective policies returned by AWS Organizations are supported.
+func NewTagPolicy(content string) (*TagPolicy, error) {
+	var document struct {
+		Tags map[string]struct {
+			TagKey   json.RawMessage `json:"tag_key"`
+			TagValue json.RawMessage `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("error parsing tag policy: %w", err)
+	}
+
+	policy := &TagPolicy{
+		Tags: make(map[string]*TagPolicyTag),
+	}
+
+	for k, v := range document.Tags {
+		tag := &TagPolicyTag{}
+
+		if err := unmarshalTagPolicyValue(v.TagKey, &tag.Key); err != nil {
+			return nil, fmt.Errorf("error parsing tag policy tag (%s) tag_key: %w", k, err)
+		}
+
+		if err := unmarshalTagPolicyValue(v.TagValue, &tag.Values); err != nil {
+			return nil, fmt.Errorf("error parsing tag policy tag (%s) tag_value: %w", k, err)
+		}
+
+		policy.Tags[strings.ToLower(k)] = tag
+	}
+
+	return policy, nil
+}
+
+// CheckTagPolicy returns an error if any tags do not comply with a tag policy.
+// Tags are checked for the required keys, tag key capitalization and allowed tag values.
+func (tags KeyValueTags) CheckTagPolicy(config *TagPolicyConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	requiredKeys := config.RequiredKeys.Keys()
+	sort.Strings(requiredKeys)
+
+	for _, k := range requiredKeys {
+		if !tags.KeyExists(k) {
+			errs = multierror.Append(errs, fmt.Errorf("required tag key (%s) is missing", k))
+		}
+	}
+
+	if config.Policy == nil {
+		return errs.ErrorOrNil()
+	}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		policyTag, ok := config.Policy.Tags[strings.ToLower(k)]
+
+		if !ok {
+			continue
+		}
+
+		if policyTag.Key != "" && k != policyTag.Key {
+			errs = multierror.Append(errs, fmt.Errorf("tag key (%s) does not match the capitalization in the tag policy (%s)", k, policyTag.Key))
+		}
+
+		if len(policyTag.Values) == 0 {
+			continue
+		}
+
+		var value string
+
+		if v := tags.KeyValue(k); v != nil {
+			value = *v
+		}
+
+		if !policyTag.allowsValue(value) {
+			errs = multierror.Append(errs, fmt.Errorf("tag (%s) value (%s) is not allowed by the tag policy, expected one of: %s", k, value, strings.Join(policyTag.Values, ", ")))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// allowsValue returns whether the value matches any of the allowed values.
+func (tag *TagPolicyTag) allowsValue(value string) bool {
+	for _, allowed := range tag.Values {
+		i := strings.Index(allowed, tagPolicyValueWildcard)
+
+		if i == -1 {
+			if value == allowed {
+				return true
+			}
+
+			continue
+		}
+
+		prefix, suffix := allowed[:i], allowed[i+len(tagPolicyValueWildcard):]
+
+		if len(value) >= len(prefix)+len(suffix) && strings.HasPrefix(value, prefix) && strings.HasSuffix(value, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unmarshalTagPolicyValue unmarshals a tag policy value, which may either be
+// the value itself or an object with the value under the "@@assign" operator.
+func unmarshalTagPolicyValue(data json.RawMessage, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var operators map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &operators); err == nil {
+		data = operators[tagPolicyAssignOperator]
+
+		if len(data) == 0 {
+			return nil
+		}
+	}
+
+	return json.Unmarshal(data, v)
+}
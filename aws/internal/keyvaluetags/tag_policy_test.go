@@ -0,0 +1,233 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"testing"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+func TestNewTagPolicy(t *testing.T) {
+	testCases := []struct {
+		name      string
+		content   string
+		want      map[string]*TagPolicyTag
+		wantError bool
+	}{
+		{
+			name:    "empty",
+			content: `{}`,
+			want:    map[string]*TagPolicyTag{},
+		},
+		{
+			name: "assign operators",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter",
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      },
+      "tag_value": {
+        "@@assign": ["100", "200*"]
+      },
+      "enforced_for": {
+        "@@assign": ["ec2:instance"]
+      }
+    },
+    "Project": {
+      "tag_key": {
+        "@@assign": "Project"
+      }
+    }
+  }
+}`,
+			want: map[string]*TagPolicyTag{
+				"costcenter": {
+					Key:    "CostCenter",
+					Values: []string{"100", "200*"},
+				},
+				"project": {
+					Key: "Project",
+				},
+			},
+		},
+		{
+			name:    "effective policy",
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter","tag_value":["100","200"]}}}`,
+			want: map[string]*TagPolicyTag{
+				"costcenter": {
+					Key:    "CostCenter",
+					Values: []string{"100", "200"},
+				},
+			},
+		},
+		{
+			name:      "invalid JSON",
+			content:   `{"tags":`,
+			wantError: true,
+		},
+		{
+			name:      "invalid tag_value",
+			content:   `{"tags":{"costcenter":{"tag_value":{"@@assign":"100"}}}}`,
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := NewTagPolicy(testCase.content)
+
+			if testCase.wantError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got.Tags, testCase.want) {
+				t.Errorf("got %#v, expected %#v", got.Tags, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsCheckTagPolicy(t *testing.T) {
+	policy := &TagPolicy{
+		Tags: map[string]*TagPolicyTag{
+			"costcenter": {
+				Key:    "CostCenter",
+				Values: []string{"100", "200*", "*-test"},
+			},
+			"project": {
+				Key: "Project",
+			},
+			"owner": {},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		tags     KeyValueTags
+		config   *TagPolicyConfig
+		wantErrs int
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{
+				"costcenter": "300",
+			}),
+		},
+		{
+			name: "compliant",
+			tags: New(map[string]string{
+				"CostCenter": "100",
+				"Project":    "test",
+				"owner":      "test",
+				"Other":      "test",
+			}),
+			config: &TagPolicyConfig{
+				Policy: policy,
+			},
+		},
+		{
+			name: "wildcard values",
+			tags: New(map[string]string{
+				"CostCenter": "2001",
+			}),
+			config: &TagPolicyConfig{
+				Policy: policy,
+			},
+		},
+		{
+			name: "leading wildcard value",
+			tags: New(map[string]string{
+				"CostCenter": "300-test",
+			}),
+			config: &TagPolicyConfig{
+				Policy: policy,
+			},
+		},
+		{
+			name: "capitalization",
+			tags: New(map[string]string{
+				"costcenter": "100",
+				"PROJECT":    "test",
+			}),
+			config: &TagPolicyConfig{
+				Policy: policy,
+			},
+			wantErrs: 2,
+		},
+		{
+			name: "value not allowed",
+			tags: New(map[string]string{
+				"CostCenter": "300",
+			}),
+			config: &TagPolicyConfig{
+				Policy: policy,
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "nil value not allowed",
+			tags: New(map[string]*string{
+				"CostCenter": nil,
+			}),
+			config: &TagPolicyConfig{
+				Policy: policy,
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "required keys",
+			tags: New(map[string]string{
+				"Project": "test",
+			}),
+			config: &TagPolicyConfig{
+				RequiredKeys: New([]string{"CostCenter", "Project"}),
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "required keys and policy",
+			tags: New(map[string]string{
+				"project": "test",
+			}),
+			config: &TagPolicyConfig{
+				Policy:       policy,
+				RequiredKeys: New([]string{"CostCenter", "Project"}),
+			},
+			wantErrs: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.tags.CheckTagPolicy(testCase.config)
+
+			if testCase.wantErrs == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			merr, ok := err.(*multierror.Error)
+
+			if !ok {
+				t.Fatalf("expected *multierror.Error, got: %#v", err)
+			}
+
+			if got, want := len(merr.Errors), testCase.wantErrs; got != want {
+				t.Errorf("got %d errors, expected %d: %s", got, want, err)
+			}
+		})
+	}
+}
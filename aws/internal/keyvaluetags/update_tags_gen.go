@@ -126,6 +126,10 @@ func AccessanalyzerUpdateTags(conn *accessanalyzer.AccessAnalyzer, identifier st
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("accessanalyzer"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &accessanalyzer.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -162,6 +166,10 @@ func AcmUpdateTags(conn *acm.ACM, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("acm"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &acm.RemoveTagsFromCertificateInput{
 			CertificateArn: aws.String(identifier),
@@ -198,6 +206,10 @@ func AcmpcaUpdateTags(conn *acmpca.ACMPCA, identifier string, oldTagsMap interfa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("acmpca"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &acmpca.UntagCertificateAuthorityInput{
 			CertificateAuthorityArn: aws.String(identifier),
@@ -234,6 +246,10 @@ func AmplifyUpdateTags(conn *amplify.Amplify, identifier string, oldTagsMap inte
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("amplify"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &amplify.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -270,6 +286,10 @@ func ApigatewayUpdateTags(conn *apigateway.APIGateway, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("apigateway"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &apigateway.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -306,6 +326,10 @@ func Apigatewayv2UpdateTags(conn *apigatewayv2.ApiGatewayV2, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("apigatewayv2"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &apigatewayv2.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -342,6 +366,10 @@ func AppmeshUpdateTags(conn *appmesh.AppMesh, identifier string, oldTagsMap inte
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("appmesh"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &appmesh.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -378,6 +406,10 @@ func AppstreamUpdateTags(conn *appstream.AppStream, identifier string, oldTagsMa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("appstream"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &appstream.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -414,6 +446,10 @@ func AppsyncUpdateTags(conn *appsync.AppSync, identifier string, oldTagsMap inte
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("appsync"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &appsync.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -450,6 +486,10 @@ func AthenaUpdateTags(conn *athena.Athena, identifier string, oldTagsMap interfa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("athena"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &athena.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -486,6 +526,10 @@ func AutoscalingUpdateTags(conn *autoscaling.AutoScaling, identifier string, res
 	oldTags := AutoscalingKeyValueTags(oldTagsSet, identifier, resourceType)
 	newTags := AutoscalingKeyValueTags(newTagsSet, identifier, resourceType)
 
+	if err := newTags.IgnoreAws().Validate("autoscaling"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &autoscaling.DeleteTagsInput{
 			Tags: removedTags.IgnoreAws().AutoscalingTags(),
@@ -520,6 +564,10 @@ func BackupUpdateTags(conn *backup.Backup, identifier string, oldTagsMap interfa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("backup"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &backup.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -556,6 +604,10 @@ func BatchUpdateTags(conn *batch.Batch, identifier string, oldTagsMap interface{
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("batch"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &batch.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -592,6 +644,10 @@ func Cloud9UpdateTags(conn *cloud9.Cloud9, identifier string, oldTagsMap interfa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cloud9"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cloud9.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -628,6 +684,10 @@ func CloudfrontUpdateTags(conn *cloudfront.CloudFront, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cloudfront"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cloudfront.UntagResourceInput{
 			Resource: aws.String(identifier),
@@ -664,6 +724,10 @@ func Cloudhsmv2UpdateTags(conn *cloudhsmv2.CloudHSMV2, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cloudhsmv2"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cloudhsmv2.UntagResourceInput{
 			ResourceId: aws.String(identifier),
@@ -700,6 +764,10 @@ func CloudtrailUpdateTags(conn *cloudtrail.CloudTrail, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cloudtrail"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cloudtrail.RemoveTagsInput{
 			ResourceId: aws.String(identifier),
@@ -736,6 +804,10 @@ func CloudwatchUpdateTags(conn *cloudwatch.CloudWatch, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cloudwatch"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cloudwatch.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -772,6 +844,10 @@ func CloudwatcheventsUpdateTags(conn *cloudwatchevents.CloudWatchEvents, identif
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cloudwatchevents"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cloudwatchevents.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -808,6 +884,10 @@ func CloudwatchlogsUpdateTags(conn *cloudwatchlogs.CloudWatchLogs, identifier st
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cloudwatchlogs"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cloudwatchlogs.UntagLogGroupInput{
 			LogGroupName: aws.String(identifier),
@@ -844,6 +924,10 @@ func CodeartifactUpdateTags(conn *codeartifact.CodeArtifact, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("codeartifact"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &codeartifact.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -880,6 +964,10 @@ func CodecommitUpdateTags(conn *codecommit.CodeCommit, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("codecommit"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &codecommit.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -916,6 +1004,10 @@ func CodedeployUpdateTags(conn *codedeploy.CodeDeploy, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("codedeploy"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &codedeploy.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -952,6 +1044,10 @@ func CodepipelineUpdateTags(conn *codepipeline.CodePipeline, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("codepipeline"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &codepipeline.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -988,6 +1084,10 @@ func CodestarnotificationsUpdateTags(conn *codestarnotifications.CodeStarNotific
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("codestarnotifications"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &codestarnotifications.UntagResourceInput{
 			Arn:     aws.String(identifier),
@@ -1024,6 +1124,10 @@ func CognitoidentityUpdateTags(conn *cognitoidentity.CognitoIdentity, identifier
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cognitoidentity"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cognitoidentity.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1060,6 +1164,10 @@ func CognitoidentityproviderUpdateTags(conn *cognitoidentityprovider.CognitoIden
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("cognitoidentityprovider"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &cognitoidentityprovider.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1096,6 +1204,10 @@ func ConfigserviceUpdateTags(conn *configservice.ConfigService, identifier strin
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("configservice"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &configservice.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1132,6 +1244,10 @@ func DatabasemigrationserviceUpdateTags(conn *databasemigrationservice.DatabaseM
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("databasemigrationservice"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &databasemigrationservice.RemoveTagsFromResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1168,6 +1284,10 @@ func DataexchangeUpdateTags(conn *dataexchange.DataExchange, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("dataexchange"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &dataexchange.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1204,6 +1324,10 @@ func DatapipelineUpdateTags(conn *datapipeline.DataPipeline, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("datapipeline"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &datapipeline.RemoveTagsInput{
 			PipelineId: aws.String(identifier),
@@ -1240,6 +1364,10 @@ func DatasyncUpdateTags(conn *datasync.DataSync, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("datasync"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &datasync.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1276,6 +1404,10 @@ func DaxUpdateTags(conn *dax.DAX, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("dax"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &dax.UntagResourceInput{
 			ResourceName: aws.String(identifier),
@@ -1312,6 +1444,10 @@ func DevicefarmUpdateTags(conn *devicefarm.DeviceFarm, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("devicefarm"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &devicefarm.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -1348,6 +1484,10 @@ func DirectconnectUpdateTags(conn *directconnect.DirectConnect, identifier strin
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("directconnect"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &directconnect.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1384,6 +1524,10 @@ func DirectoryserviceUpdateTags(conn *directoryservice.DirectoryService, identif
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("directoryservice"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &directoryservice.RemoveTagsFromResourceInput{
 			ResourceId: aws.String(identifier),
@@ -1420,6 +1564,10 @@ func DlmUpdateTags(conn *dlm.DLM, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("dlm"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &dlm.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1456,6 +1604,10 @@ func DocdbUpdateTags(conn *docdb.DocDB, identifier string, oldTagsMap interface{
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("docdb"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &docdb.RemoveTagsFromResourceInput{
 			ResourceName: aws.String(identifier),
@@ -1492,6 +1644,10 @@ func DynamodbUpdateTags(conn *dynamodb.DynamoDB, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("dynamodb"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &dynamodb.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1528,6 +1684,10 @@ func Ec2UpdateTags(conn *ec2.EC2, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("ec2"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &ec2.DeleteTagsInput{
 			Resources: aws.StringSlice([]string{identifier}),
@@ -1564,6 +1724,10 @@ func EcrUpdateTags(conn *ecr.ECR, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("ecr"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &ecr.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1600,6 +1764,10 @@ func EcsUpdateTags(conn *ecs.ECS, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("ecs"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &ecs.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1636,6 +1804,10 @@ func EfsUpdateTags(conn *efs.EFS, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("efs"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &efs.UntagResourceInput{
 			ResourceId: aws.String(identifier),
@@ -1672,6 +1844,10 @@ func EksUpdateTags(conn *eks.EKS, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("eks"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &eks.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -1708,6 +1884,10 @@ func ElasticacheUpdateTags(conn *elasticache.ElastiCache, identifier string, old
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("elasticache"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &elasticache.RemoveTagsFromResourceInput{
 			ResourceName: aws.String(identifier),
@@ -1743,6 +1923,11 @@ func ElasticacheUpdateTags(conn *elasticache.ElastiCache, identifier string, old
 func ElasticbeanstalkUpdateTags(conn *elasticbeanstalk.ElasticBeanstalk, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
+
+	if err := newTags.IgnoreAws().Validate("elasticbeanstalk"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	removedTags := oldTags.Removed(newTags)
 	updatedTags := oldTags.Updated(newTags)
 
@@ -1779,6 +1964,10 @@ func ElasticsearchserviceUpdateTags(conn *elasticsearchservice.ElasticsearchServ
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("elasticsearchservice"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &elasticsearchservice.RemoveTagsInput{
 			ARN:     aws.String(identifier),
@@ -1815,6 +2004,10 @@ func ElbUpdateTags(conn *elb.ELB, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("elb"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &elb.RemoveTagsInput{
 			LoadBalancerNames: aws.StringSlice([]string{identifier}),
@@ -1851,6 +2044,10 @@ func Elbv2UpdateTags(conn *elbv2.ELBV2, identifier string, oldTagsMap interface{
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("elbv2"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &elbv2.RemoveTagsInput{
 			ResourceArns: aws.StringSlice([]string{identifier}),
@@ -1887,6 +2084,10 @@ func EmrUpdateTags(conn *emr.EMR, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("emr"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &emr.RemoveTagsInput{
 			ResourceId: aws.String(identifier),
@@ -1923,6 +2124,10 @@ func FirehoseUpdateTags(conn *firehose.Firehose, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("firehose"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &firehose.UntagDeliveryStreamInput{
 			DeliveryStreamName: aws.String(identifier),
@@ -1959,6 +2164,10 @@ func FsxUpdateTags(conn *fsx.FSx, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("fsx"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &fsx.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -1995,6 +2204,10 @@ func GameliftUpdateTags(conn *gamelift.GameLift, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("gamelift"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &gamelift.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -2031,6 +2244,10 @@ func GlacierUpdateTags(conn *glacier.Glacier, identifier string, oldTagsMap inte
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("glacier"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &glacier.RemoveTagsFromVaultInput{
 			VaultName: aws.String(identifier),
@@ -2067,6 +2284,10 @@ func GlobalacceleratorUpdateTags(conn *globalaccelerator.GlobalAccelerator, iden
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("globalaccelerator"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &globalaccelerator.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2103,6 +2324,10 @@ func GlueUpdateTags(conn *glue.Glue, identifier string, oldTagsMap interface{},
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("glue"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &glue.UntagResourceInput{
 			ResourceArn:  aws.String(identifier),
@@ -2139,6 +2364,10 @@ func GreengrassUpdateTags(conn *greengrass.Greengrass, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("greengrass"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &greengrass.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2175,6 +2404,10 @@ func GuarddutyUpdateTags(conn *guardduty.GuardDuty, identifier string, oldTagsMa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("guardduty"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &guardduty.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2211,6 +2444,10 @@ func ImagebuilderUpdateTags(conn *imagebuilder.Imagebuilder, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("imagebuilder"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &imagebuilder.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2247,6 +2484,10 @@ func IotUpdateTags(conn *iot.IoT, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("iot"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &iot.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2283,6 +2524,10 @@ func IotanalyticsUpdateTags(conn *iotanalytics.IoTAnalytics, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("iotanalytics"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &iotanalytics.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2319,6 +2564,10 @@ func IoteventsUpdateTags(conn *iotevents.IoTEvents, identifier string, oldTagsMa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("iotevents"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &iotevents.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2355,6 +2604,10 @@ func KafkaUpdateTags(conn *kafka.Kafka, identifier string, oldTagsMap interface{
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("kafka"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &kafka.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2391,6 +2644,10 @@ func KinesisUpdateTags(conn *kinesis.Kinesis, identifier string, oldTagsMap inte
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("kinesis"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		for _, removedTags := range removedTags.Chunks(10) {
 			input := &kinesis.RemoveTagsFromStreamInput{
@@ -2431,6 +2688,10 @@ func KinesisanalyticsUpdateTags(conn *kinesisanalytics.KinesisAnalytics, identif
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("kinesisanalytics"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &kinesisanalytics.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -2467,6 +2728,10 @@ func Kinesisanalyticsv2UpdateTags(conn *kinesisanalyticsv2.KinesisAnalyticsV2, i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("kinesisanalyticsv2"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &kinesisanalyticsv2.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -2503,6 +2768,10 @@ func KinesisvideoUpdateTags(conn *kinesisvideo.KinesisVideo, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("kinesisvideo"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &kinesisvideo.UntagStreamInput{
 			StreamARN:  aws.String(identifier),
@@ -2539,6 +2808,10 @@ func KmsUpdateTags(conn *kms.KMS, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("kms"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &kms.UntagResourceInput{
 			KeyId:   aws.String(identifier),
@@ -2575,6 +2848,10 @@ func LambdaUpdateTags(conn *lambda.Lambda, identifier string, oldTagsMap interfa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("lambda"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &lambda.UntagResourceInput{
 			Resource: aws.String(identifier),
@@ -2611,6 +2888,10 @@ func LicensemanagerUpdateTags(conn *licensemanager.LicenseManager, identifier st
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("licensemanager"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &licensemanager.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2647,6 +2928,10 @@ func LightsailUpdateTags(conn *lightsail.Lightsail, identifier string, oldTagsMa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("lightsail"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &lightsail.UntagResourceInput{
 			ResourceName: aws.String(identifier),
@@ -2683,6 +2968,10 @@ func MediaconnectUpdateTags(conn *mediaconnect.MediaConnect, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("mediaconnect"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &mediaconnect.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2719,6 +3008,10 @@ func MediaconvertUpdateTags(conn *mediaconvert.MediaConvert, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("mediaconvert"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &mediaconvert.UntagResourceInput{
 			Arn:     aws.String(identifier),
@@ -2755,6 +3048,10 @@ func MedialiveUpdateTags(conn *medialive.MediaLive, identifier string, oldTagsMa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("medialive"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &medialive.DeleteTagsInput{
 			ResourceArn: aws.String(identifier),
@@ -2791,6 +3088,10 @@ func MediapackageUpdateTags(conn *mediapackage.MediaPackage, identifier string,
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("mediapackage"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &mediapackage.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2827,6 +3128,10 @@ func MediastoreUpdateTags(conn *mediastore.MediaStore, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("mediastore"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &mediastore.UntagResourceInput{
 			Resource: aws.String(identifier),
@@ -2863,6 +3168,10 @@ func MqUpdateTags(conn *mq.MQ, identifier string, oldTagsMap interface{}, newTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("mq"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &mq.DeleteTagsInput{
 			ResourceArn: aws.String(identifier),
@@ -2899,6 +3208,10 @@ func NeptuneUpdateTags(conn *neptune.Neptune, identifier string, oldTagsMap inte
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("neptune"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &neptune.RemoveTagsFromResourceInput{
 			ResourceName: aws.String(identifier),
@@ -2935,6 +3248,10 @@ func NetworkfirewallUpdateTags(conn *networkfirewall.NetworkFirewall, identifier
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("networkfirewall"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &networkfirewall.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -2971,6 +3288,10 @@ func NetworkmanagerUpdateTags(conn *networkmanager.NetworkManager, identifier st
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("networkmanager"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &networkmanager.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3007,6 +3328,10 @@ func OpsworksUpdateTags(conn *opsworks.OpsWorks, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("opsworks"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &opsworks.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3043,6 +3368,10 @@ func OrganizationsUpdateTags(conn *organizations.Organizations, identifier strin
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("organizations"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &organizations.UntagResourceInput{
 			ResourceId: aws.String(identifier),
@@ -3079,6 +3408,10 @@ func PinpointUpdateTags(conn *pinpoint.Pinpoint, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("pinpoint"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &pinpoint.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3115,6 +3448,10 @@ func QldbUpdateTags(conn *qldb.QLDB, identifier string, oldTagsMap interface{},
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("qldb"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &qldb.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3151,6 +3488,10 @@ func QuicksightUpdateTags(conn *quicksight.QuickSight, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("quicksight"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &quicksight.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3187,6 +3528,10 @@ func RamUpdateTags(conn *ram.RAM, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("ram"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &ram.UntagResourceInput{
 			ResourceShareArn: aws.String(identifier),
@@ -3223,6 +3568,10 @@ func RdsUpdateTags(conn *rds.RDS, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("rds"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &rds.RemoveTagsFromResourceInput{
 			ResourceName: aws.String(identifier),
@@ -3259,6 +3608,10 @@ func RedshiftUpdateTags(conn *redshift.Redshift, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("redshift"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &redshift.DeleteTagsInput{
 			ResourceName: aws.String(identifier),
@@ -3295,6 +3648,10 @@ func ResourcegroupsUpdateTags(conn *resourcegroups.ResourceGroups, identifier st
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("resourcegroups"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &resourcegroups.UntagInput{
 			Arn:  aws.String(identifier),
@@ -3330,6 +3687,11 @@ func ResourcegroupsUpdateTags(conn *resourcegroups.ResourceGroups, identifier st
 func Route53UpdateTags(conn *route53.Route53, identifier string, resourceType string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
+
+	if err := newTags.IgnoreAws().Validate("route53"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	removedTags := oldTags.Removed(newTags)
 	updatedTags := oldTags.Updated(newTags)
 
@@ -3367,6 +3729,10 @@ func Route53resolverUpdateTags(conn *route53resolver.Route53Resolver, identifier
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("route53resolver"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &route53resolver.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3403,6 +3769,10 @@ func SagemakerUpdateTags(conn *sagemaker.SageMaker, identifier string, oldTagsMa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("sagemaker"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &sagemaker.DeleteTagsInput{
 			ResourceArn: aws.String(identifier),
@@ -3439,6 +3809,10 @@ func SecretsmanagerUpdateTags(conn *secretsmanager.SecretsManager, identifier st
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("secretsmanager"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &secretsmanager.UntagResourceInput{
 			SecretId: aws.String(identifier),
@@ -3475,6 +3849,10 @@ func SecurityhubUpdateTags(conn *securityhub.SecurityHub, identifier string, old
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("securityhub"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &securityhub.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3511,6 +3889,10 @@ func ServicediscoveryUpdateTags(conn *servicediscovery.ServiceDiscovery, identif
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("servicediscovery"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &servicediscovery.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -3547,6 +3929,10 @@ func SfnUpdateTags(conn *sfn.SFN, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("sfn"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &sfn.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3583,6 +3969,10 @@ func SignerUpdateTags(conn *signer.Signer, identifier string, oldTagsMap interfa
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("signer"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &signer.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3619,6 +4009,10 @@ func SnsUpdateTags(conn *sns.SNS, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("sns"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &sns.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3655,6 +4049,10 @@ func SqsUpdateTags(conn *sqs.SQS, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("sqs"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &sqs.UntagQueueInput{
 			QueueUrl: aws.String(identifier),
@@ -3691,6 +4089,10 @@ func SsmUpdateTags(conn *ssm.SSM, identifier string, resourceType string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("ssm"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &ssm.RemoveTagsFromResourceInput{
 			ResourceId:   aws.String(identifier),
@@ -3729,6 +4131,10 @@ func SsoadminUpdateTags(conn *ssoadmin.SSOAdmin, identifier string, resourceType
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("ssoadmin"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &ssoadmin.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3767,6 +4173,10 @@ func StoragegatewayUpdateTags(conn *storagegateway.StorageGateway, identifier st
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("storagegateway"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &storagegateway.RemoveTagsFromResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -3803,6 +4213,10 @@ func SwfUpdateTags(conn *swf.SWF, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("swf"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &swf.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3839,6 +4253,10 @@ func SyntheticsUpdateTags(conn *synthetics.Synthetics, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("synthetics"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &synthetics.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -3875,6 +4293,10 @@ func TransferUpdateTags(conn *transfer.Transfer, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("transfer"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &transfer.UntagResourceInput{
 			Arn:     aws.String(identifier),
@@ -3911,6 +4333,10 @@ func WafUpdateTags(conn *waf.WAF, identifier string, oldTagsMap interface{}, new
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("waf"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &waf.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -3947,6 +4373,10 @@ func WafregionalUpdateTags(conn *wafregional.WAFRegional, identifier string, old
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("wafregional"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &waf.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -3983,6 +4413,10 @@ func Wafv2UpdateTags(conn *wafv2.WAFV2, identifier string, oldTagsMap interface{
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("wafv2"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &wafv2.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
@@ -4019,6 +4453,10 @@ func WorklinkUpdateTags(conn *worklink.WorkLink, identifier string, oldTagsMap i
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("worklink"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &worklink.UntagResourceInput{
 			ResourceArn: aws.String(identifier),
@@ -4055,6 +4493,10 @@ func WorkspacesUpdateTags(conn *workspaces.WorkSpaces, identifier string, oldTag
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("workspaces"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &workspaces.DeleteTagsInput{
 			ResourceId: aws.String(identifier),
@@ -4091,6 +4533,10 @@ func XrayUpdateTags(conn *xray.XRay, identifier string, oldTagsMap interface{},
 	oldTags := New(oldTagsMap)
 	newTags := New(newTagsMap)
 
+	if err := newTags.IgnoreAws().Validate("xray"); err != nil {
+		return fmt.Errorf("error validating tags for resource (%s): %w", identifier, err)
+	}
+
 	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
 		input := &xray.UntagResourceInput{
 			ResourceARN: aws.String(identifier),
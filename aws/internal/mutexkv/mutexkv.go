@@ -1,48 +1,188 @@
 package mutexkv
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
 	"sync"
+	"time"
 )
 
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+// Keys are released once they are no longer locked or waited on.
 type MutexKV struct {
-	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	lock    sync.Mutex
+	store   map[string]*mutex
+	options Options
+}
+
+// Options configures a MutexKV.
+type Options struct {
+	// DebugThreshold, if set, is the time a lock can be held before
+	// the holder and wait time are logged.
+	DebugThreshold time.Duration
+}
+
+// OptionsFunc modifies the default Options.
+type OptionsFunc func(*Options)
+
+// WithDebugThreshold enables logging of the holder and wait time
+// when a lock is held for longer than the threshold.
+func WithDebugThreshold(threshold time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.DebugThreshold = threshold
+	}
+}
+
+// mutex is a lock that can be waited on with a context.
+// All fields except sem are guarded by MutexKV.lock.
+type mutex struct {
+	// sem holds a value while the mutex is locked.
+	sem chan struct{}
+
+	// refs is the number of holders and waiters.
+	refs int
+
+	holder   string
+	lockedAt time.Time
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *MutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	// The error is always nil as the context is never done.
+	_ = m.lockContext(context.Background(), key, caller())
+}
+
+// LockContext locks the mutex for the given key, waiting until the context is done.
+// If the context is done before the lock is obtained, an error is returned and
+// the caller must not call Unlock. Otherwise the caller is responsible for
+// calling Unlock for the same key.
+func (m *MutexKV) LockContext(ctx context.Context, key string) error {
+	return m.lockContext(ctx, key, caller())
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *MutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+
+	m.lock.Lock()
+	mu, ok := m.store[key]
+	if ok {
+		if threshold := m.options.DebugThreshold; threshold > 0 {
+			if heldFor := time.Since(mu.lockedAt); heldFor > threshold {
+				log.Printf("[WARN] Lock %q was held by %s for %s", key, mu.holder, heldFor)
+			}
+		}
+		mu.holder = ""
+		mu.lockedAt = time.Time{}
+	}
+	m.lock.Unlock()
+
+	if !ok {
+		panic(fmt.Sprintf("mutexkv: unlock of unlocked key %q", key))
+	}
+
+	select {
+	case <-mu.sem:
+	default:
+		panic(fmt.Sprintf("mutexkv: unlock of unlocked key %q", key))
+	}
+
+	m.release(key, mu)
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
+func (m *MutexKV) lockContext(ctx context.Context, key string, holder string) error {
+	log.Printf("[DEBUG] Locking %q", key)
+
+	mu := m.acquire(key)
+	start := time.Now()
+
+	var debugC <-chan time.Time
+
+	if threshold := m.options.DebugThreshold; threshold > 0 {
+		ticker := time.NewTicker(threshold)
+		defer ticker.Stop()
+		debugC = ticker.C
+	}
+
+	for {
+		select {
+		case mu.sem <- struct{}{}:
+			m.lock.Lock()
+			mu.holder = holder
+			mu.lockedAt = time.Now()
+			m.lock.Unlock()
+
+			log.Printf("[DEBUG] Locked %q", key)
+			return nil
+		case <-ctx.Done():
+			m.release(key, mu)
+
+			return fmt.Errorf("error waiting %s to lock %q: %w", time.Since(start).Round(time.Millisecond), key, ctx.Err())
+		case <-debugC:
+			m.lock.Lock()
+			currentHolder, heldFor := mu.holder, time.Since(mu.lockedAt)
+			m.lock.Unlock()
+
+			log.Printf("[WARN] %s has waited %s to lock %q, held by %s for %s", holder, time.Since(start).Round(time.Second), key, currentHolder, heldFor.Round(time.Second))
+		}
+	}
+}
+
+// acquire returns the mutex for the given key, no guarantee of its lock status.
+// The caller must call release once it no longer holds or waits on the mutex.
+func (m *MutexKV) acquire(key string) *mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mu, ok := m.store[key]
+	if !ok {
+		mu = &mutex{
+			sem: make(chan struct{}, 1),
+		}
+		m.store[key] = mu
+	}
+	mu.refs++
+	return mu
+}
+
+// release removes the mutex for the given key once it is no longer held or waited on.
+func (m *MutexKV) release(key string, mu *mutex) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	mu.refs--
+	if mu.refs == 0 {
+		delete(m.store, key)
+	}
+}
+
+// caller returns the name and location of the function calling an exported MutexKV method.
+func caller() string {
+	pc, file, line, ok := runtime.Caller(2)
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		return "unknown"
 	}
-	return mutex
+
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		return fmt.Sprintf("%s (%s:%d)", fn.Name(), file, line)
+	}
+
+	return fmt.Sprintf("%s:%d", file, line)
 }
 
 // Returns a properly initialized MutexKV
-func NewMutexKV() *MutexKV {
-	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+func NewMutexKV(optFns ...OptionsFunc) *MutexKV {
+	m := &MutexKV{
+		store: make(map[string]*mutex),
 	}
+
+	for _, optFn := range optFns {
+		optFn(&m.options)
+	}
+
+	return m
 }
@@ -1,6 +1,13 @@
 package mutexkv
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -65,3 +72,141 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockContext(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVLockContextUnlock(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- mkv.LockContext(context.Background(), "foo")
+	}()
+
+	select {
+	case <-errCh:
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	mkv.Unlock("foo")
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second lock blocked after unlock. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVRelease(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+	mkv.Lock("bar")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := len(mkv.store), 2; got != want {
+		t.Errorf("got %d keys, expected %d", got, want)
+	}
+
+	mkv.Unlock("foo")
+	mkv.Unlock("bar")
+
+	if got, want := len(mkv.store), 0; got != want {
+		t.Errorf("got %d keys, expected %d", got, want)
+	}
+}
+
+func TestMutexKVUnlockUnlocked(t *testing.T) {
+	mkv := NewMutexKV()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Unlock of an unlocked key did not panic. This shouldn't happen.")
+		}
+	}()
+
+	mkv.Unlock("foo")
+}
+
+func TestMutexKVDebugThreshold(t *testing.T) {
+	var buf safeBuffer
+
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	mkv := NewMutexKV(WithDebugThreshold(10 * time.Millisecond))
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	mkv.Unlock("foo")
+
+	output := buf.String()
+
+	for _, want := range []string{
+		`to lock "foo", held by`,
+		`Lock "foo" was held by`,
+		"TestMutexKVDebugThreshold",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected log output to contain %q, got: %s", want, output)
+		}
+	}
+}
+
+// safeBuffer is a bytes.Buffer that can be written to concurrently.
+type safeBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *safeBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *safeBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,138 @@
+package naming
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// RandomSuffixLength is the length of the random suffix of names generated
+// when the name prefix is too long for the built-in unique ID suffix.
+const RandomSuffixLength = 8
+
+var randomSuffixRegexp = regexp.MustCompile(fmt.Sprintf("[[:xdigit:]]{%d}$", RandomSuffixLength))
+
+// Constraints describes the maximum length and allowed characters of a name field.
+//
+// Names are generated with the built-in unique ID suffix if it fits within the
+// maximum length, otherwise with a shorter random suffix. For example:
+//
+// var lbNameConstraints = naming.Constraints{
+//   MaxLength: 32,
+//   Charset:   `0-9A-Za-z-`,
+// }
+type Constraints struct {
+	// MaxLength is the maximum length of the name. Required.
+	MaxLength int
+
+	// Charset is the allowed characters, as the contents of a regular
+	// expression bracket expression, e.g. `0-9A-Za-z-`. Generated suffixes
+	// only contain lowercase hexadecimal digits. Optional, defaults to any character.
+	Charset string
+
+	// DefaultPrefix is the prefix of fully generated names.
+	// Optional, defaults to terraform-.
+	DefaultPrefix string
+}
+
+// Generate returns in order the name if non-empty, a prefix generated name if non-empty,
+// or fully generated name prefixed with the default prefix.
+// The generated suffix is shortened to RandomSuffixLength random characters if needed
+// to fit the maximum length.
+func (c Constraints) Generate(name string, namePrefix string) string {
+	if name != "" {
+		return name
+	}
+
+	if namePrefix == "" {
+		namePrefix = c.defaultPrefix()
+	}
+
+	if len(namePrefix)+resource.UniqueIDSuffixLength <= c.MaxLength {
+		return resource.PrefixedUniqueId(namePrefix)
+	}
+
+	if maxPrefixLength := c.MaxNamePrefixLength(); len(namePrefix) > maxPrefixLength {
+		namePrefix = namePrefix[:maxPrefixLength]
+	}
+
+	return namePrefix + randomSuffix()
+}
+
+// MaxNamePrefixLength returns the maximum length of a name prefix.
+func (c Constraints) MaxNamePrefixLength() int {
+	return c.MaxLength - RandomSuffixLength
+}
+
+// NamePrefixFromName returns a name prefix if the string matches prefix criteria.
+// Both the built-in unique ID suffix and the shorter random suffix are recognized.
+// See NamePrefixFromName.
+func (c Constraints) NamePrefixFromName(name string) *string {
+	var namePrefix string
+
+	switch {
+	case HasResourceUniqueIdSuffix(name):
+		namePrefix = name[:len(name)-resource.UniqueIDSuffixLength]
+	// A random suffix is only generated when the built-in unique ID suffix does not fit.
+	case len(name)-RandomSuffixLength > c.MaxLength-resource.UniqueIDSuffixLength && randomSuffixRegexp.MatchString(name):
+		namePrefix = name[:len(name)-RandomSuffixLength]
+	default:
+		return nil
+	}
+
+	// The name may have been fully generated (e.g. omitting both name and name_prefix arguments)
+	if namePrefix == "" || namePrefix == c.defaultPrefix() {
+		return nil
+	}
+
+	return &namePrefix
+}
+
+// ValidateName returns a SchemaValidateFunc which checks the length and characters of a name.
+func (c Constraints) ValidateName() schema.SchemaValidateFunc {
+	return c.validate(c.MaxLength)
+}
+
+// ValidateNamePrefix returns a SchemaValidateFunc which checks the length and characters of a name prefix.
+func (c Constraints) ValidateNamePrefix() schema.SchemaValidateFunc {
+	return c.validate(c.MaxNamePrefixLength())
+}
+
+func (c Constraints) validate(maxLength int) schema.SchemaValidateFunc {
+	validators := []schema.SchemaValidateFunc{
+		validation.StringLenBetween(1, maxLength),
+	}
+
+	if c.Charset != "" {
+		validators = append(validators, validation.StringMatch(
+			regexp.MustCompile(fmt.Sprintf("^[%s]+$", c.Charset)),
+			fmt.Sprintf("only characters matching [%s] are allowed", c.Charset),
+		))
+	}
+
+	return validation.All(validators...)
+}
+
+func (c Constraints) defaultPrefix() string {
+	if c.DefaultPrefix == "" {
+		return resource.UniqueIdPrefix
+	}
+
+	return c.DefaultPrefix
+}
+
+// randomSuffix returns RandomSuffixLength random lowercase hexadecimal digits.
+func randomSuffix() string {
+	b := make([]byte, RandomSuffixLength/2)
+
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Errorf("error generating random name suffix: %w", err))
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,220 @@
+package naming
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestConstraintsGenerate(t *testing.T) {
+	lbConstraints := Constraints{
+		MaxLength:     32,
+		Charset:       `0-9A-Za-z-`,
+		DefaultPrefix: "tf-lb-",
+	}
+
+	testCases := []struct {
+		TestName              string
+		Constraints           Constraints
+		Name                  string
+		NamePrefix            string
+		ExpectedRegexpPattern string
+	}{
+		{
+			TestName:              "name",
+			Constraints:           lbConstraints,
+			Name:                  "test",
+			NamePrefix:            "",
+			ExpectedRegexpPattern: "^test$",
+		},
+		{
+			TestName:              "short name prefix",
+			Constraints:           lbConstraints,
+			Name:                  "",
+			NamePrefix:            "test-",
+			ExpectedRegexpPattern: resourcePrefixedUniqueIDRegexpPattern("test-"),
+		},
+		{
+			TestName:              "long name prefix",
+			Constraints:           lbConstraints,
+			Name:                  "",
+			NamePrefix:            "test-load-balancer-",
+			ExpectedRegexpPattern: fmt.Sprintf("^test-load-balancer-[[:xdigit:]]{%d}$", RandomSuffixLength),
+		},
+		{
+			TestName:              "too long name prefix",
+			Constraints:           lbConstraints,
+			Name:                  "",
+			NamePrefix:            "test-load-balancer-with-a-long-name-",
+			ExpectedRegexpPattern: fmt.Sprintf("^test-load-balancer-with-[[:xdigit:]]{%d}$", RandomSuffixLength),
+		},
+		{
+			TestName:              "fully generated",
+			Constraints:           lbConstraints,
+			Name:                  "",
+			NamePrefix:            "",
+			ExpectedRegexpPattern: resourcePrefixedUniqueIDRegexpPattern("tf-lb-"),
+		},
+		{
+			TestName:              "fully generated default prefix",
+			Constraints:           Constraints{MaxLength: 32},
+			Name:                  "",
+			NamePrefix:            "",
+			ExpectedRegexpPattern: fmt.Sprintf("^terraform-[[:xdigit:]]{%d}$", RandomSuffixLength),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := testCase.Constraints.Generate(testCase.Name, testCase.NamePrefix)
+
+			expectedRegexp, err := regexp.Compile(testCase.ExpectedRegexpPattern)
+
+			if err != nil {
+				t.Errorf("unable to compile regular expression pattern %s: %s", testCase.ExpectedRegexpPattern, err)
+			}
+
+			if !expectedRegexp.MatchString(got) {
+				t.Errorf("got %s, expected to match regular expression pattern %s", got, testCase.ExpectedRegexpPattern)
+			}
+
+			if len(got) > testCase.Constraints.MaxLength {
+				t.Errorf("got %s, expected at most %d characters", got, testCase.Constraints.MaxLength)
+			}
+		})
+	}
+}
+
+func TestConstraintsNamePrefixFromName(t *testing.T) {
+	constraints := Constraints{
+		MaxLength:     32,
+		DefaultPrefix: "tf-",
+	}
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected *string
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			TestName: "incorrect suffix",
+			Input:    "test-123",
+			Expected: nil,
+		},
+		{
+			TestName: "unique ID suffix",
+			Input:    "test-20060102150405000000000001",
+			Expected: strPtr("test-"),
+		},
+		{
+			TestName: "random suffix",
+			Input:    "test-load-balancer-0123abcd",
+			Expected: strPtr("test-load-balancer-"),
+		},
+		{
+			TestName: "random suffix too short for generated name",
+			Input:    "test-0123abcd",
+			Expected: nil,
+		},
+		{
+			TestName: "default prefix",
+			Input:    "tf-20060102150405000000000001",
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			expected := testCase.Expected
+			got := constraints.NamePrefixFromName(testCase.Input)
+
+			if expected == nil && got != nil {
+				t.Errorf("got %s, expected nil", *got)
+			}
+
+			if expected != nil && got == nil {
+				t.Errorf("got nil, expected %s", *expected)
+			}
+
+			if expected != nil && got != nil && *expected != *got {
+				t.Errorf("got %s, expected %s", *got, *expected)
+			}
+		})
+	}
+
+	t.Run("extracting prefix from generated name", func(t *testing.T) {
+		for _, prefix := range []string{"test-", "test-load-balancer-", "test-load-balancer-abc"} {
+			input := constraints.Generate("", prefix)
+			got := constraints.NamePrefixFromName(input)
+
+			if got == nil {
+				t.Errorf("run (%s): expected prefix, got nil", input)
+				continue
+			}
+
+			if *got != prefix {
+				t.Errorf("run (%s): expected prefix %q, got %q", input, prefix, *got)
+			}
+		}
+	})
+}
+
+func TestConstraintsValidate(t *testing.T) {
+	constraints := Constraints{
+		MaxLength: 32,
+		Charset:   `0-9A-Za-z-`,
+	}
+
+	testCases := []struct {
+		TestName        string
+		Input           string
+		ExpectNameErr   bool
+		ExpectPrefixErr bool
+	}{
+		{
+			TestName: "valid",
+			Input:    "test-1",
+		},
+		{
+			TestName:        "empty",
+			Input:           "",
+			ExpectNameErr:   true,
+			ExpectPrefixErr: true,
+		},
+		{
+			TestName:        "invalid characters",
+			Input:           "test_1",
+			ExpectNameErr:   true,
+			ExpectPrefixErr: true,
+		},
+		{
+			TestName:        "too long for prefix",
+			Input:           strings.Repeat("a", 25),
+			ExpectPrefixErr: true,
+		},
+		{
+			TestName:        "too long",
+			Input:           strings.Repeat("a", 33),
+			ExpectNameErr:   true,
+			ExpectPrefixErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if _, errs := constraints.ValidateName()(testCase.Input, "name"); (len(errs) > 0) != testCase.ExpectNameErr {
+				t.Errorf("got name errors %v, expected error: %t", errs, testCase.ExpectNameErr)
+			}
+
+			if _, errs := constraints.ValidateNamePrefix()(testCase.Input, "name_prefix"); (len(errs) > 0) != testCase.ExpectPrefixErr {
+				t.Errorf("got name_prefix errors %v, expected error: %t", errs, testCase.ExpectPrefixErr)
+			}
+		})
+	}
+}
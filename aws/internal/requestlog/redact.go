@@ -0,0 +1,125 @@
+package requestlog
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Redacted replaces the values of sensitive fields.
+const Redacted = "[REDACTED]"
+
+// sensitiveFieldNames are redacted in addition to fields tagged as sensitive
+// in the AWS Go SDK API models, which are not tagged consistently.
+var sensitiveFieldNames = map[string]bool{
+	"AccessKeyId":     true,
+	"AuthToken":       true,
+	"PrivateKey":      true,
+	"SecretAccessKey": true,
+	"SecretBinary":    true,
+	"SecretString":    true,
+	"SessionToken":    true,
+}
+
+var (
+	readerType = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// Redact returns a copy of AWS Go SDK input or output shapes as maps, slices
+// and scalars suitable for JSON encoding. Fields tagged as sensitive, or with a
+// sensitive name, are replaced with Redacted. Blobs and streams are replaced
+// with their size or a placeholder.
+func Redact(v interface{}) interface{} {
+	return redactValue(reflect.ValueOf(v))
+}
+
+func redactValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Implements(readerType) {
+			return "[stream]"
+		}
+
+		return redactValue(v.Elem())
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).UTC().Format(time.RFC3339)
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			// Skip unexported fields, including the _ struct{} of every shape.
+			if field.PkgPath != "" {
+				continue
+			}
+
+			fieldValue := v.Field(i)
+
+			if isNil(fieldValue) {
+				continue
+			}
+
+			if isSensitive(field) {
+				m[field.Name] = Redacted
+				continue
+			}
+
+			m[field.Name] = redactValue(fieldValue)
+		}
+
+		return m
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("[%d bytes]", v.Len())
+		}
+
+		l := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			l[i] = redactValue(v.Index(i))
+		}
+
+		return l
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+
+		for _, key := range v.MapKeys() {
+			m[fmt.Sprint(key.Interface())] = redactValue(v.MapIndex(key))
+		}
+
+		return m
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return nil
+	}
+
+	return v.Interface()
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+func isSensitive(field reflect.StructField) bool {
+	return field.Tag.Get("sensitive") == "true" || sensitiveFieldNames[field.Name] || strings.Contains(field.Name, "Password")
+}
@@ -0,0 +1,104 @@
+package requestlog
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testShape struct {
+	_ struct{} `type:"structure"`
+
+	Body io.ReadSeeker `type:"blob"`
+
+	CreatedAt *time.Time `type:"timestamp"`
+
+	Data []byte `type:"blob"`
+
+	Filters []*testFilter `type:"list"`
+
+	MasterUserPassword *string `type:"string"`
+
+	Name *string `type:"string"`
+
+	NextToken *string `type:"string"`
+
+	SecretString *string `type:"string"`
+
+	Tags map[string]*string `type:"map"`
+
+	Value *string `type:"string" sensitive:"true"`
+
+	Variables map[string]*string `type:"map" sensitive:"true"`
+}
+
+type testFilter struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `type:"string"`
+
+	Values []*string `type:"list"`
+}
+
+func TestRedact(t *testing.T) {
+	createdAt := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := func(v string) *string { return &v }
+
+	input := &testShape{
+		Body:      bytes.NewReader([]byte("secret")),
+		CreatedAt: &createdAt,
+		Data:      []byte("secret"),
+		Filters: []*testFilter{
+			{
+				Name:   s("vpc-id"),
+				Values: []*string{s("vpc-12345678")},
+			},
+		},
+		MasterUserPassword: s("secret"),
+		Name:               s("test"),
+		SecretString:       s("secret"),
+		Tags: map[string]*string{
+			"Name": s("test"),
+		},
+		Value:     s("secret"),
+		Variables: map[string]*string{"KEY": s("secret")},
+	}
+
+	expected := map[string]interface{}{
+		"Body":      "[stream]",
+		"CreatedAt": "2021-01-02T03:04:05Z",
+		"Data":      "[6 bytes]",
+		"Filters": []interface{}{
+			map[string]interface{}{
+				"Name":   "vpc-id",
+				"Values": []interface{}{"vpc-12345678"},
+			},
+		},
+		"MasterUserPassword": Redacted,
+		"Name":               "test",
+		"SecretString":       Redacted,
+		"Tags": map[string]interface{}{
+			"Name": "test",
+		},
+		"Value":     Redacted,
+		"Variables": Redacted,
+	}
+
+	if got := Redact(input); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
+
+func TestRedactNil(t *testing.T) {
+	var input *testShape
+
+	if got := Redact(input); got != nil {
+		t.Errorf("got %#v, expected nil", got)
+	}
+
+	if got := Redact(nil); got != nil {
+		t.Errorf("got %#v, expected nil", got)
+	}
+}
@@ -0,0 +1,122 @@
+// Package requestlog implements structured logging of AWS Go SDK API requests
+// with sensitive request parameters redacted.
+package requestlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// EnvFormat is the environment variable selecting the log format.
+	// Valid values are "text", the default, and "json" for JSON lines.
+	EnvFormat = "TF_AWS_REQUEST_LOG_FORMAT"
+
+	FormatJSON = "json"
+	FormatText = "text"
+
+	handlerName = "terraform-provider-aws.requestlog.Log"
+)
+
+// logf is replaced in testing.
+var logf = log.Printf
+
+// Entry is the structured log record of a completed API request.
+type Entry struct {
+	Service    string      `json:"service"`
+	Operation  string      `json:"operation"`
+	StatusCode int         `json:"status_code,omitempty"`
+	LatencyMs  int64       `json:"latency_ms"`
+	RetryCount int         `json:"retry_count"`
+	RequestID  string      `json:"request_id,omitempty"`
+	ErrorCode  string      `json:"error_code,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
+}
+
+// NewEntry returns the log record of a completed request.
+// Request parameters are redacted.
+func NewEntry(r *request.Request) *Entry {
+	entry := &Entry{
+		Service:    r.ClientInfo.ServiceName,
+		RetryCount: r.RetryCount,
+		RequestID:  r.RequestID,
+		Parameters: Redact(r.Params),
+	}
+
+	if r.Operation != nil {
+		entry.Operation = r.Operation.Name
+	}
+
+	if r.HTTPResponse != nil {
+		entry.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if !r.Time.IsZero() {
+		entry.LatencyMs = time.Since(r.Time).Milliseconds()
+	}
+
+	if r.Error != nil {
+		if awsErr, ok := r.Error.(awserr.Error); ok {
+			entry.ErrorCode = awsErr.Code()
+		} else {
+			entry.ErrorCode = "UnknownError"
+		}
+	}
+
+	return entry
+}
+
+// String returns the entry in the text log format.
+func (e *Entry) String() string {
+	parameters, _ := json.Marshal(e.Parameters)
+
+	return fmt.Sprintf("AWS API request: service=%s operation=%s status_code=%d latency_ms=%d retry_count=%d request_id=%s error_code=%s parameters=%s",
+		e.Service, e.Operation, e.StatusCode, e.LatencyMs, e.RetryCount, e.RequestID, e.ErrorCode, parameters)
+}
+
+// JSON returns the entry in the JSON lines log format.
+func (e *Entry) JSON() string {
+	b, err := json.Marshal(e)
+
+	if err != nil {
+		return fmt.Sprintf(`{"service":%q,"operation":%q,"error":%q}`, e.Service, e.Operation, err)
+	}
+
+	return string(b)
+}
+
+// Install adds a handler logging every completed request, after all retries,
+// at DEBUG level. The format is read from the EnvFormat environment variable.
+func Install(handlers *request.Handlers) {
+	format := Format()
+
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: handlerName,
+		Fn: func(r *request.Request) {
+			entry := NewEntry(r)
+
+			if format == FormatJSON {
+				logf("[DEBUG] %s", entry.JSON())
+				return
+			}
+
+			logf("[DEBUG] %s", entry)
+		},
+	})
+}
+
+// Format returns the log format configured by the EnvFormat environment variable.
+func Format() string {
+	if strings.EqualFold(strings.TrimSpace(os.Getenv(EnvFormat)), FormatJSON) {
+		return FormatJSON
+	}
+
+	return FormatText
+}
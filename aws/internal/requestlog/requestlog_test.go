@@ -0,0 +1,110 @@
+package requestlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestInstall(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Format   string
+		Expected func(t *testing.T, line string)
+	}{
+		{
+			Name:   "text",
+			Format: "",
+			Expected: func(t *testing.T, line string) {
+				for _, s := range []string{
+					"[DEBUG] AWS API request:",
+					"service=secretsmanager",
+					"operation=PutSecretValue",
+					"status_code=400",
+					"retry_count=2",
+					"request_id=abc-123",
+					"error_code=ThrottlingException",
+					`parameters={"SecretId":"test","SecretString":"[REDACTED]"}`,
+				} {
+					if !strings.Contains(line, s) {
+						t.Errorf("expected %q in log line: %s", s, line)
+					}
+				}
+			},
+		},
+		{
+			Name:   "json",
+			Format: "JSON",
+			Expected: func(t *testing.T, line string) {
+				var entry Entry
+
+				if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "[DEBUG] ")), &entry); err != nil {
+					t.Fatalf("error decoding log line (%s): %s", line, err)
+				}
+
+				if entry.Service != "secretsmanager" || entry.Operation != "PutSecretValue" || entry.RetryCount != 2 || entry.RequestID != "abc-123" {
+					t.Errorf("unexpected entry: %#v", entry)
+				}
+
+				if entry.LatencyMs < 1000 {
+					t.Errorf("got latency %dms, expected at least 1000ms", entry.LatencyMs)
+				}
+
+				if strings.Contains(line, "secret-value") {
+					t.Errorf("expected secret to be redacted: %s", line)
+				}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			os.Setenv(EnvFormat, testCase.Format)
+			defer os.Unsetenv(EnvFormat)
+
+			var lines []string
+			logf = func(format string, v ...interface{}) {
+				lines = append(lines, fmt.Sprintf(format, v...))
+			}
+			defer func() { logf = log.Printf }()
+
+			handlers := request.Handlers{}
+			Install(&handlers)
+
+			secretID, secretString := "test", "secret-value"
+			r := &request.Request{
+				ClientInfo:   metadata.ClientInfo{ServiceName: "secretsmanager"},
+				Error:        awserr.New("ThrottlingException", "Rate exceeded", nil),
+				HTTPResponse: &http.Response{StatusCode: 400},
+				Operation:    &request.Operation{Name: "PutSecretValue"},
+				Params: &struct {
+					SecretId     *string
+					SecretString *string `sensitive:"true"`
+				}{
+					SecretId:     &secretID,
+					SecretString: &secretString,
+				},
+				RequestID:  "abc-123",
+				RetryCount: 2,
+				Time:       time.Now().Add(-1 * time.Second),
+			}
+
+			handlers.Complete.Run(r)
+
+			if got, expected := len(lines), 1; got != expected {
+				t.Fatalf("got %d log lines, expected %d", got, expected)
+			}
+
+			testCase.Expected(t, lines[0])
+		})
+	}
+}
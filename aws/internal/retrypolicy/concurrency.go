@@ -0,0 +1,137 @@
+package retrypolicy
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	concurrencyLimitHandlerName        = "terraform-provider-aws.retrypolicy.ConcurrencyLimit"
+	concurrencyLimitReleaseHandlerName = "terraform-provider-aws.retrypolicy.ConcurrencyLimitRelease"
+)
+
+// readOnlyOperationPrefixes are the API operation name prefixes of operations
+// which are not limited by a ConcurrencyLimit without operations.
+var readOnlyOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+}
+
+// ConcurrencyLimit limits the number of concurrent requests for API operations.
+type ConcurrencyLimit struct {
+	// Maximum number of concurrent requests. Required.
+	MaxConcurrent int
+
+	// API operation names the limit applies to. A trailing "*" matches
+	// operation names by prefix. Optional, defaults to all create, update
+	// and delete operations, i.e. operations not starting with Describe,
+	// Get, Head or List.
+	Operations []string
+
+	semaphore Semaphore
+}
+
+// Matches returns whether the API operation is limited.
+func (l ConcurrencyLimit) Matches(operation string) bool {
+	if len(l.Operations) > 0 {
+		return operationMatches(l.Operations, operation)
+	}
+
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Semaphore limits the number of concurrent holders.
+type Semaphore chan struct{}
+
+// NewSemaphore returns a Semaphore allowing up to the given number of concurrent holders.
+func NewSemaphore(size int) Semaphore {
+	return make(Semaphore, size)
+}
+
+// Acquire takes a slot, blocking until one is available or the context is done.
+func (s Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire.
+func (s Semaphore) Release() {
+	// Make the Release non-blocking. This can happen if an Acquire was never issued
+	select {
+	case <-s:
+	default:
+		log.Println("[WARN] Releasing semaphore without Acquire")
+	}
+}
+
+// installConcurrencyLimits adds the request handlers for the concurrency limits to the given handlers.
+// A request holds its slots from its first attempt until it completes, including any retries.
+func installConcurrencyLimits(handlers *request.Handlers, limits []ConcurrencyLimit) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: concurrencyLimitHandlerName,
+		Fn: func(r *request.Request) {
+			// Sign handlers run for every attempt and for presigned requests,
+			// which never complete.
+			if r.RetryCount > 0 || r.ExpireTime > 0 || r.Operation == nil {
+				return
+			}
+
+			for _, limit := range limits {
+				if limit.semaphore == nil || !limit.Matches(r.Operation.Name) {
+					continue
+				}
+
+				if err := limit.semaphore.Acquire(r.Context()); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode, "request concurrency limit wait canceled", err)
+					return
+				}
+
+				semaphore := limit.semaphore
+
+				// Complete handlers run once per request. The handlers are a copy for each request.
+				r.Handlers.Complete.PushBackNamed(request.NamedHandler{
+					Name: concurrencyLimitReleaseHandlerName,
+					Fn: func(r *request.Request) {
+						semaphore.Release()
+					},
+				})
+			}
+		},
+	})
+}
+
+// operationMatches returns whether the API operation name matches any of the operations.
+// A trailing "*" matches operation names by prefix.
+func operationMatches(operations []string, name string) bool {
+	for _, operation := range operations {
+		if prefix := strings.TrimSuffix(operation, "*"); prefix != operation {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+
+			continue
+		}
+
+		if name == operation {
+			return true
+		}
+	}
+
+	return false
+}
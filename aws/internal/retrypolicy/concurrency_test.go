@@ -0,0 +1,175 @@
+package retrypolicy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestConcurrencyLimitMatches(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		ConcurrencyLimit ConcurrencyLimit
+		Operation        string
+		Expected         bool
+	}{
+		{
+			Name:             "default create",
+			ConcurrencyLimit: ConcurrencyLimit{MaxConcurrent: 1},
+			Operation:        "CreateDBInstance",
+			Expected:         true,
+		},
+		{
+			Name:             "default change",
+			ConcurrencyLimit: ConcurrencyLimit{MaxConcurrent: 1},
+			Operation:        "ChangeResourceRecordSets",
+			Expected:         true,
+		},
+		{
+			Name:             "default describe",
+			ConcurrencyLimit: ConcurrencyLimit{MaxConcurrent: 1},
+			Operation:        "DescribeDBInstances",
+			Expected:         false,
+		},
+		{
+			Name:             "default list",
+			ConcurrencyLimit: ConcurrencyLimit{MaxConcurrent: 1},
+			Operation:        "ListResourceRecordSets",
+			Expected:         false,
+		},
+		{
+			Name:             "operation",
+			ConcurrencyLimit: ConcurrencyLimit{MaxConcurrent: 1, Operations: []string{"CreateDBInstance"}},
+			Operation:        "CreateDBInstance",
+			Expected:         true,
+		},
+		{
+			Name:             "different operation",
+			ConcurrencyLimit: ConcurrencyLimit{MaxConcurrent: 1, Operations: []string{"CreateDBInstance"}},
+			Operation:        "DeleteDBInstance",
+			Expected:         false,
+		},
+		{
+			Name:             "operation prefix",
+			ConcurrencyLimit: ConcurrencyLimit{MaxConcurrent: 1, Operations: []string{"Describe*"}},
+			Operation:        "DescribeDBInstances",
+			Expected:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.ConcurrencyLimit.Matches(testCase.Operation); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSemaphoreAcquireContextCanceled(t *testing.T) {
+	semaphore := NewSemaphore(1)
+
+	if err := semaphore.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := semaphore.Acquire(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, expected %v", err, context.DeadlineExceeded)
+	}
+
+	semaphore.Release()
+
+	if err := semaphore.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestPolicyInstallConcurrencyLimit(t *testing.T) {
+	policy := &Policy{
+		RequestsPerSecond: 10,
+		ConcurrencyLimits: []ConcurrencyLimit{
+			{
+				MaxConcurrent: 1,
+				Operations:    []string{"ChangeResourceRecordSets"},
+			},
+		},
+	}
+
+	handlers := request.Handlers{}
+	policy.Install(&handlers)
+
+	if got, expected := handlers.Sign.Len(), 2; got != expected {
+		t.Fatalf("got %d sign handlers, expected %d", got, expected)
+	}
+
+	semaphore := policy.ConcurrencyLimits[0].semaphore
+
+	if semaphore == nil {
+		t.Fatal("expected semaphore to be created")
+	}
+
+	newRequest := func(operation string) *request.Request {
+		return &request.Request{
+			Handlers:    handlers.Copy(),
+			HTTPRequest: &http.Request{},
+			Operation:   &request.Operation{Name: operation},
+		}
+	}
+
+	r1 := newRequest("ChangeResourceRecordSets")
+	r1.Handlers.Sign.Run(r1)
+
+	if r1.Error != nil {
+		t.Fatalf("unexpected error: %s", r1.Error)
+	}
+
+	if got, expected := len(semaphore), 1; got != expected {
+		t.Fatalf("got %d holders, expected %d", got, expected)
+	}
+
+	// Retries keep the slot.
+	r1.RetryCount = 1
+	r1.Handlers.Sign.Run(r1)
+
+	if got, expected := len(semaphore), 1; got != expected {
+		t.Fatalf("got %d holders, expected %d", got, expected)
+	}
+
+	// Other operations are not limited.
+	r2 := newRequest("GetChange")
+	r2.Handlers.Sign.Run(r2)
+
+	if r2.Error != nil {
+		t.Fatalf("unexpected error: %s", r2.Error)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	r3 := newRequest("ChangeResourceRecordSets")
+	r3.SetContext(ctx)
+	r3.Handlers.Sign.Run(r3)
+
+	if awsErr, ok := r3.Error.(awserr.Error); !ok || awsErr.Code() != request.CanceledErrorCode {
+		t.Fatalf("got error %v, expected %s", r3.Error, request.CanceledErrorCode)
+	}
+
+	r3.Handlers.Complete.Run(r3)
+
+	if got, expected := len(semaphore), 1; got != expected {
+		t.Fatalf("got %d holders, expected %d", got, expected)
+	}
+
+	r1.Handlers.Complete.Run(r1)
+
+	if got, expected := len(semaphore), 0; got != expected {
+		t.Fatalf("got %d holders, expected %d", got, expected)
+	}
+}
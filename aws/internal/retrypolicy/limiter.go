@@ -0,0 +1,75 @@
+package retrypolicy
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket rate limiter.
+// Tokens are added at a fixed rate per second up to a maximum burst size.
+// Each request takes a single token, waiting for one to become available if the bucket is empty.
+type Limiter struct {
+	burst  float64
+	last   time.Time
+	mu     sync.Mutex
+	now    func() time.Time
+	rate   float64
+	tokens float64
+}
+
+// NewLimiter returns a Limiter allowing rate requests per second with the given burst size.
+// If burst is less than one, it defaults to the rate rounded up (minimum of one).
+func NewLimiter(rate float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	return &Limiter{
+		burst:  float64(burst),
+		now:    time.Now,
+		rate:   rate,
+		tokens: float64(burst),
+	}
+}
+
+// Wait takes a token, blocking until one is available or the context is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
@@ -0,0 +1,93 @@
+package retrypolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	now := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(2, 2)
+	limiter.now = func() time.Time { return now }
+
+	// Initial burst.
+	for i := 0; i < 2; i++ {
+		if got := limiter.reserve(); got != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, got)
+		}
+	}
+
+	// Bucket is empty, each further request waits for the next token.
+	if got, expected := limiter.reserve(), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	if got, expected := limiter.reserve(), 1*time.Second; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	// Tokens are refilled over time, but never above the burst size.
+	now = now.Add(1 * time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if got := limiter.reserve(); got != 0 {
+			t.Fatalf("request %d after refill: got delay %s, expected none", i, got)
+		}
+	}
+
+	if got, expected := limiter.reserve(), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s after refill, expected %s", got, expected)
+	}
+}
+
+func TestNewLimiterDefaultBurst(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Rate     float64
+		Burst    int
+		Expected float64
+	}{
+		{
+			Name:     "explicit burst",
+			Rate:     5,
+			Burst:    10,
+			Expected: 10,
+		},
+		{
+			Name:     "rate rounded up",
+			Rate:     2.5,
+			Expected: 3,
+		},
+		{
+			Name:     "minimum of one",
+			Rate:     0.1,
+			Expected: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			limiter := NewLimiter(testCase.Rate, testCase.Burst)
+
+			if limiter.burst != testCase.Expected {
+				t.Errorf("got burst %f, expected %f", limiter.burst, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestLimiterWaitContextCanceled(t *testing.T) {
+	limiter := NewLimiter(0.001, 1)
+
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := limiter.Wait(ctx); err != context.Canceled {
+		t.Errorf("got error %v, expected %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,193 @@
+// Package retrypolicy implements client-side request rate limiting, concurrency
+// limiting and retry policies which can be installed on AWS Go SDK service clients.
+package retrypolicy
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	rateLimitHandlerName      = "terraform-provider-aws.retrypolicy.RateLimit"
+	retryableErrorHandlerName = "terraform-provider-aws.retrypolicy.RetryableErrors"
+)
+
+// Policy contains the request rate limit and retry settings for a service.
+type Policy struct {
+	// Maximum number of requests per second. Zero disables rate limiting.
+	RequestsPerSecond float64
+
+	// Maximum number of requests allowed in a single burst.
+	// Defaults to RequestsPerSecond rounded up.
+	Burst int
+
+	// Maximum delay between retries. Zero keeps the AWS Go SDK default.
+	MaxBackoff time.Duration
+
+	// Errors which should always be retried.
+	RetryableErrors []RetryableError
+
+	// Limits on the number of concurrent requests.
+	ConcurrencyLimits []ConcurrencyLimit
+
+	limiter *Limiter
+}
+
+// RetryableError describes an AWS error which should be retried.
+type RetryableError struct {
+	// AWS error code. Required.
+	Code string
+
+	// Substring of the AWS error message. Optional.
+	Message string
+
+	// API operation names the error is retryable for. A trailing "*" matches
+	// operation names by prefix. Optional, defaults to all operations.
+	Operations []string
+
+	// Maximum number of retries for the error. Once reached, the request is
+	// explicitly marked as not retryable. Optional, defaults to the client retry count.
+	MaxRetries int
+}
+
+// Merge returns a new Policy with the settings of other overriding those of the policy.
+// Retryable errors are combined.
+func (p *Policy) Merge(other *Policy) *Policy {
+	if p == nil {
+		return other
+	}
+
+	result := *p
+	result.limiter = nil
+	result.RetryableErrors = append([]RetryableError{}, p.RetryableErrors...)
+	result.ConcurrencyLimits = nil
+
+	for _, limit := range p.ConcurrencyLimits {
+		limit.semaphore = nil
+		result.ConcurrencyLimits = append(result.ConcurrencyLimits, limit)
+	}
+
+	if other == nil {
+		return &result
+	}
+
+	if other.RequestsPerSecond > 0 {
+		result.RequestsPerSecond = other.RequestsPerSecond
+		result.Burst = other.Burst
+	}
+
+	if other.MaxBackoff > 0 {
+		result.MaxBackoff = other.MaxBackoff
+	}
+
+	result.RetryableErrors = append(result.RetryableErrors, other.RetryableErrors...)
+	result.ConcurrencyLimits = append(result.ConcurrencyLimits, other.ConcurrencyLimits...)
+
+	return &result
+}
+
+// Retryer returns the AWS Go SDK retryer for the policy,
+// or nil if the policy does not customize the maximum backoff.
+func (p *Policy) Retryer(maxRetries int) request.Retryer {
+	if p == nil || p.MaxBackoff <= 0 {
+		return nil
+	}
+
+	return client.DefaultRetryer{
+		NumMaxRetries:    maxRetries,
+		MaxRetryDelay:    p.MaxBackoff,
+		MaxThrottleDelay: p.MaxBackoff,
+	}
+}
+
+// Install adds the request handlers for the policy to the given handlers.
+// Handlers sharing a Policy share its rate and concurrency limits.
+func (p *Policy) Install(handlers *request.Handlers) {
+	if p == nil {
+		return
+	}
+
+	if p.RequestsPerSecond > 0 {
+		if p.limiter == nil {
+			p.limiter = NewLimiter(p.RequestsPerSecond, p.Burst)
+		}
+
+		limiter := p.limiter
+
+		// Sign handlers run for every attempt, including retries.
+		handlers.Sign.PushFrontNamed(request.NamedHandler{
+			Name: rateLimitHandlerName,
+			Fn: func(r *request.Request) {
+				if err := limiter.Wait(r.Context()); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode, "request rate limit wait canceled", err)
+				}
+			},
+		})
+	}
+
+	if len(p.ConcurrencyLimits) > 0 {
+		for i := range p.ConcurrencyLimits {
+			if p.ConcurrencyLimits[i].semaphore == nil && p.ConcurrencyLimits[i].MaxConcurrent > 0 {
+				p.ConcurrencyLimits[i].semaphore = NewSemaphore(p.ConcurrencyLimits[i].MaxConcurrent)
+			}
+		}
+
+		// Concurrency limits are waited on before the rate limit.
+		installConcurrencyLimits(handlers, p.ConcurrencyLimits)
+	}
+
+	if len(p.RetryableErrors) > 0 {
+		retryableErrors := p.RetryableErrors
+
+		handlers.Retry.PushBackNamed(request.NamedHandler{
+			Name: retryableErrorHandlerName,
+			Fn: func(r *request.Request) {
+				for _, retryableError := range retryableErrors {
+					if !retryableError.Matches(r) {
+						continue
+					}
+
+					if retryableError.MaxRetries > 0 && r.RetryCount >= retryableError.MaxRetries {
+						r.Retryable = aws.Bool(false)
+						return
+					}
+
+					r.Retryable = aws.Bool(true)
+					return
+				}
+			},
+		})
+	}
+}
+
+// Matches returns whether the request error and operation match the retryable error.
+func (e RetryableError) Matches(r *request.Request) bool {
+	awsErr, ok := r.Error.(awserr.Error)
+
+	if !ok || awsErr == nil {
+		return false
+	}
+
+	if awsErr.Code() != e.Code {
+		return false
+	}
+
+	if e.Message != "" && !strings.Contains(awsErr.Message(), e.Message) {
+		return false
+	}
+
+	if len(e.Operations) == 0 {
+		return true
+	}
+
+	if r.Operation == nil {
+		return false
+	}
+
+	return operationMatches(e.Operations, r.Operation.Name)
+}
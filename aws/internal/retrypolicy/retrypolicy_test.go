@@ -0,0 +1,270 @@
+package retrypolicy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestRetryableErrorMatches(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		RetryableError RetryableError
+		Operation      string
+		Err            error
+		Expected       bool
+	}{
+		{
+			Name:           "no error",
+			RetryableError: RetryableError{Code: "Throttling"},
+			Operation:      "DescribeInstances",
+			Expected:       false,
+		},
+		{
+			Name:           "non-AWS error",
+			RetryableError: RetryableError{Code: "Throttling"},
+			Operation:      "DescribeInstances",
+			Err:            errors.New("Throttling"),
+			Expected:       false,
+		},
+		{
+			Name:           "code",
+			RetryableError: RetryableError{Code: "Throttling"},
+			Operation:      "DescribeInstances",
+			Err:            awserr.New("Throttling", "Rate exceeded", nil),
+			Expected:       true,
+		},
+		{
+			Name:           "different code",
+			RetryableError: RetryableError{Code: "Throttling"},
+			Operation:      "DescribeInstances",
+			Err:            awserr.New("ValidationException", "Rate exceeded", nil),
+			Expected:       false,
+		},
+		{
+			Name:           "code and message",
+			RetryableError: RetryableError{Code: "ConflictException", Message: "try again later"},
+			Operation:      "CreateDeployment",
+			Err:            awserr.New("ConflictException", "Unable to complete operation due to concurrent modification. Please try again later.", nil),
+			Expected:       true,
+		},
+		{
+			Name:           "code and different message",
+			RetryableError: RetryableError{Code: "ConflictException", Message: "try again later"},
+			Operation:      "CreateDeployment",
+			Err:            awserr.New("ConflictException", "Resource already exists", nil),
+			Expected:       false,
+		},
+		{
+			Name:           "operation",
+			RetryableError: RetryableError{Code: "Throttling", Operations: []string{"CreateStream"}},
+			Operation:      "CreateStream",
+			Err:            awserr.New("Throttling", "Rate exceeded", nil),
+			Expected:       true,
+		},
+		{
+			Name:           "different operation",
+			RetryableError: RetryableError{Code: "Throttling", Operations: []string{"CreateStream"}},
+			Operation:      "DeleteStream",
+			Err:            awserr.New("Throttling", "Rate exceeded", nil),
+			Expected:       false,
+		},
+		{
+			Name:           "operation prefix",
+			RetryableError: RetryableError{Code: "Throttling", Operations: []string{"Describe*", "List*"}},
+			Operation:      "ListStreams",
+			Err:            awserr.New("Throttling", "Rate exceeded", nil),
+			Expected:       true,
+		},
+		{
+			Name:           "different operation prefix",
+			RetryableError: RetryableError{Code: "Throttling", Operations: []string{"Describe*", "List*"}},
+			Operation:      "CreateStream",
+			Err:            awserr.New("Throttling", "Rate exceeded", nil),
+			Expected:       false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &request.Request{
+				Error:     testCase.Err,
+				Operation: &request.Operation{Name: testCase.Operation},
+			}
+
+			if got := testCase.RetryableError.Matches(r); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPolicyInstallRetryableErrors(t *testing.T) {
+	policy := &Policy{
+		RetryableErrors: []RetryableError{
+			{
+				Code:       "OrganizationAccessDeniedException",
+				MaxRetries: 2,
+			},
+		},
+	}
+
+	handlers := request.Handlers{}
+	policy.Install(&handlers)
+
+	testCases := []struct {
+		Name       string
+		Err        error
+		RetryCount int
+		Expected   *bool
+	}{
+		{
+			Name:     "no error",
+			Expected: nil,
+		},
+		{
+			Name:     "other error",
+			Err:      awserr.New("ValidationException", "", nil),
+			Expected: nil,
+		},
+		{
+			Name:       "retryable error",
+			Err:        awserr.New("OrganizationAccessDeniedException", "", nil),
+			RetryCount: 1,
+			Expected:   aws.Bool(true),
+		},
+		{
+			Name:       "retryable error maximum retries",
+			Err:        awserr.New("OrganizationAccessDeniedException", "", nil),
+			RetryCount: 2,
+			Expected:   aws.Bool(false),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &request.Request{
+				Error:      testCase.Err,
+				Operation:  &request.Operation{Name: "DescribeOrganizationConfigRules"},
+				RetryCount: testCase.RetryCount,
+			}
+
+			handlers.Retry.Run(r)
+
+			if testCase.Expected == nil {
+				if r.Retryable != nil {
+					t.Errorf("got retryable %t, expected unset", aws.BoolValue(r.Retryable))
+				}
+
+				return
+			}
+
+			if r.Retryable == nil || *r.Retryable != *testCase.Expected {
+				t.Errorf("got retryable %v, expected %t", r.Retryable, *testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPolicyInstallRateLimit(t *testing.T) {
+	policy := &Policy{
+		RequestsPerSecond: 10,
+	}
+
+	handlers1 := request.Handlers{}
+	handlers2 := request.Handlers{}
+	policy.Install(&handlers1)
+	policy.Install(&handlers2)
+
+	if got, expected := handlers1.Sign.Len(), 1; got != expected {
+		t.Fatalf("got %d sign handlers, expected %d", got, expected)
+	}
+
+	if policy.limiter == nil {
+		t.Fatal("expected rate limiter to be created")
+	}
+
+	if got, expected := handlers2.Sign.Len(), 1; got != expected {
+		t.Fatalf("got %d sign handlers, expected %d", got, expected)
+	}
+
+	if got, expected := handlers1.Retry.Len(), 0; got != expected {
+		t.Errorf("got %d retry handlers, expected %d", got, expected)
+	}
+}
+
+func TestPolicyMerge(t *testing.T) {
+	base := &Policy{
+		RequestsPerSecond: 5,
+		MaxBackoff:        10 * time.Second,
+		RetryableErrors: []RetryableError{
+			{Code: "ConflictException"},
+		},
+	}
+
+	got := base.Merge(&Policy{
+		RequestsPerSecond: 20,
+		Burst:             40,
+		RetryableErrors: []RetryableError{
+			{Code: "Throttling"},
+		},
+	})
+
+	if got.RequestsPerSecond != 20 || got.Burst != 40 {
+		t.Errorf("got rate %f and burst %d, expected 20 and 40", got.RequestsPerSecond, got.Burst)
+	}
+
+	if got.MaxBackoff != 10*time.Second {
+		t.Errorf("got max backoff %s, expected %s", got.MaxBackoff, 10*time.Second)
+	}
+
+	if len(got.RetryableErrors) != 2 {
+		t.Errorf("got %d retryable errors, expected 2", len(got.RetryableErrors))
+	}
+
+	if len(base.RetryableErrors) != 1 {
+		t.Errorf("base policy modified, got %d retryable errors", len(base.RetryableErrors))
+	}
+
+	got = got.Merge(&Policy{
+		ConcurrencyLimits: []ConcurrencyLimit{
+			{MaxConcurrent: 2},
+		},
+	})
+
+	if len(got.ConcurrencyLimits) != 1 {
+		t.Errorf("got %d concurrency limits, expected 1", len(got.ConcurrencyLimits))
+	}
+
+	var nilPolicy *Policy
+
+	if got := nilPolicy.Merge(base); got != base {
+		t.Errorf("expected merge with nil policy to return other policy")
+	}
+}
+
+func TestPolicyRetryer(t *testing.T) {
+	var nilPolicy *Policy
+
+	if nilPolicy.Retryer(25) != nil {
+		t.Error("expected no retryer for nil policy")
+	}
+
+	if (&Policy{RequestsPerSecond: 1}).Retryer(25) != nil {
+		t.Error("expected no retryer without maximum backoff")
+	}
+
+	retryer := (&Policy{MaxBackoff: 5 * time.Second}).Retryer(25)
+
+	if retryer == nil {
+		t.Fatal("expected retryer")
+	}
+
+	if got, expected := retryer.MaxRetries(), 25; got != expected {
+		t.Errorf("got max retries %d, expected %d", got, expected)
+	}
+}
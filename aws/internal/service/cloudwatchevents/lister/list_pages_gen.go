@@ -7,13 +7,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
 )
 
-func ListEventBusesPages(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListEventBusesInput, fn func(*cloudwatchevents.ListEventBusesOutput, bool) bool) error {
+func ListEventBusesPages(conn cloudwatcheventsiface.CloudWatchEventsAPI, input *cloudwatchevents.ListEventBusesInput, fn func(*cloudwatchevents.ListEventBusesOutput, bool) bool) error {
 	return ListEventBusesPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListEventBusesPagesWithContext(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListEventBusesInput, fn func(*cloudwatchevents.ListEventBusesOutput, bool) bool) error {
+func ListEventBusesPagesWithContext(ctx context.Context, conn cloudwatcheventsiface.CloudWatchEventsAPI, input *cloudwatchevents.ListEventBusesInput, fn func(*cloudwatchevents.ListEventBusesOutput, bool) bool) error {
 	for {
 		output, err := conn.ListEventBusesWithContext(ctx, input)
 		if err != nil {
@@ -30,11 +31,11 @@ func ListEventBusesPagesWithContext(ctx context.Context, conn *cloudwatchevents.
 	return nil
 }
 
-func ListRulesPages(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListRulesInput, fn func(*cloudwatchevents.ListRulesOutput, bool) bool) error {
+func ListRulesPages(conn cloudwatcheventsiface.CloudWatchEventsAPI, input *cloudwatchevents.ListRulesInput, fn func(*cloudwatchevents.ListRulesOutput, bool) bool) error {
 	return ListRulesPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListRulesPagesWithContext(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListRulesInput, fn func(*cloudwatchevents.ListRulesOutput, bool) bool) error {
+func ListRulesPagesWithContext(ctx context.Context, conn cloudwatcheventsiface.CloudWatchEventsAPI, input *cloudwatchevents.ListRulesInput, fn func(*cloudwatchevents.ListRulesOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRulesWithContext(ctx, input)
 		if err != nil {
@@ -51,11 +52,11 @@ func ListRulesPagesWithContext(ctx context.Context, conn *cloudwatchevents.Cloud
 	return nil
 }
 
-func ListTargetsByRulePages(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListTargetsByRuleInput, fn func(*cloudwatchevents.ListTargetsByRuleOutput, bool) bool) error {
+func ListTargetsByRulePages(conn cloudwatcheventsiface.CloudWatchEventsAPI, input *cloudwatchevents.ListTargetsByRuleInput, fn func(*cloudwatchevents.ListTargetsByRuleOutput, bool) bool) error {
 	return ListTargetsByRulePagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListTargetsByRulePagesWithContext(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListTargetsByRuleInput, fn func(*cloudwatchevents.ListTargetsByRuleOutput, bool) bool) error {
+func ListTargetsByRulePagesWithContext(ctx context.Context, conn cloudwatcheventsiface.CloudWatchEventsAPI, input *cloudwatchevents.ListTargetsByRuleInput, fn func(*cloudwatchevents.ListTargetsByRuleOutput, bool) bool) error {
 	for {
 		output, err := conn.ListTargetsByRuleWithContext(ctx, input)
 		if err != nil {
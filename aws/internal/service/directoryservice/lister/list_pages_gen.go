@@ -7,13 +7,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/directoryservice/directoryserviceiface"
 )
 
-func DescribeDirectoriesPages(conn *directoryservice.DirectoryService, input *directoryservice.DescribeDirectoriesInput, fn func(*directoryservice.DescribeDirectoriesOutput, bool) bool) error {
+func DescribeDirectoriesPages(conn directoryserviceiface.DirectoryServiceAPI, input *directoryservice.DescribeDirectoriesInput, fn func(*directoryservice.DescribeDirectoriesOutput, bool) bool) error {
 	return DescribeDirectoriesPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func DescribeDirectoriesPagesWithContext(ctx context.Context, conn *directoryservice.DirectoryService, input *directoryservice.DescribeDirectoriesInput, fn func(*directoryservice.DescribeDirectoriesOutput, bool) bool) error {
+func DescribeDirectoriesPagesWithContext(ctx context.Context, conn directoryserviceiface.DirectoryServiceAPI, input *directoryservice.DescribeDirectoriesInput, fn func(*directoryservice.DescribeDirectoriesOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeDirectoriesWithContext(ctx, input)
 		if err != nil {
@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=ListAttachedPolicies -input-paginator=Marker -output-paginator=NextMarker github.com/aws/aws-sdk-go/service/iot
+
+package lister
@@ -7,13 +7,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/waf/wafiface"
 )
 
-func ListByteMatchSetsPages(conn *waf.WAF, input *waf.ListByteMatchSetsInput, fn func(*waf.ListByteMatchSetsOutput, bool) bool) error {
+func ListByteMatchSetsPages(conn wafiface.WAFAPI, input *waf.ListByteMatchSetsInput, fn func(*waf.ListByteMatchSetsOutput, bool) bool) error {
 	return ListByteMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListByteMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListByteMatchSetsInput, fn func(*waf.ListByteMatchSetsOutput, bool) bool) error {
+func ListByteMatchSetsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListByteMatchSetsInput, fn func(*waf.ListByteMatchSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListByteMatchSetsWithContext(ctx, input)
 		if err != nil {
@@ -30,11 +31,11 @@ func ListByteMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input
 	return nil
 }
 
-func ListGeoMatchSetsPages(conn *waf.WAF, input *waf.ListGeoMatchSetsInput, fn func(*waf.ListGeoMatchSetsOutput, bool) bool) error {
+func ListGeoMatchSetsPages(conn wafiface.WAFAPI, input *waf.ListGeoMatchSetsInput, fn func(*waf.ListGeoMatchSetsOutput, bool) bool) error {
 	return ListGeoMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListGeoMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListGeoMatchSetsInput, fn func(*waf.ListGeoMatchSetsOutput, bool) bool) error {
+func ListGeoMatchSetsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListGeoMatchSetsInput, fn func(*waf.ListGeoMatchSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListGeoMatchSetsWithContext(ctx, input)
 		if err != nil {
@@ -51,11 +52,11 @@ func ListGeoMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input
 	return nil
 }
 
-func ListIPSetsPages(conn *waf.WAF, input *waf.ListIPSetsInput, fn func(*waf.ListIPSetsOutput, bool) bool) error {
+func ListIPSetsPages(conn wafiface.WAFAPI, input *waf.ListIPSetsInput, fn func(*waf.ListIPSetsOutput, bool) bool) error {
 	return ListIPSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListIPSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListIPSetsInput, fn func(*waf.ListIPSetsOutput, bool) bool) error {
+func ListIPSetsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListIPSetsInput, fn func(*waf.ListIPSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListIPSetsWithContext(ctx, input)
 		if err != nil {
@@ -72,11 +73,11 @@ func ListIPSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.L
 	return nil
 }
 
-func ListRateBasedRulesPages(conn *waf.WAF, input *waf.ListRateBasedRulesInput, fn func(*waf.ListRateBasedRulesOutput, bool) bool) error {
+func ListRateBasedRulesPages(conn wafiface.WAFAPI, input *waf.ListRateBasedRulesInput, fn func(*waf.ListRateBasedRulesOutput, bool) bool) error {
 	return ListRateBasedRulesPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListRateBasedRulesPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListRateBasedRulesInput, fn func(*waf.ListRateBasedRulesOutput, bool) bool) error {
+func ListRateBasedRulesPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListRateBasedRulesInput, fn func(*waf.ListRateBasedRulesOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRateBasedRulesWithContext(ctx, input)
 		if err != nil {
@@ -93,11 +94,11 @@ func ListRateBasedRulesPagesWithContext(ctx context.Context, conn *waf.WAF, inpu
 	return nil
 }
 
-func ListRegexMatchSetsPages(conn *waf.WAF, input *waf.ListRegexMatchSetsInput, fn func(*waf.ListRegexMatchSetsOutput, bool) bool) error {
+func ListRegexMatchSetsPages(conn wafiface.WAFAPI, input *waf.ListRegexMatchSetsInput, fn func(*waf.ListRegexMatchSetsOutput, bool) bool) error {
 	return ListRegexMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListRegexMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListRegexMatchSetsInput, fn func(*waf.ListRegexMatchSetsOutput, bool) bool) error {
+func ListRegexMatchSetsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListRegexMatchSetsInput, fn func(*waf.ListRegexMatchSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRegexMatchSetsWithContext(ctx, input)
 		if err != nil {
@@ -114,11 +115,11 @@ func ListRegexMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, inpu
 	return nil
 }
 
-func ListRegexPatternSetsPages(conn *waf.WAF, input *waf.ListRegexPatternSetsInput, fn func(*waf.ListRegexPatternSetsOutput, bool) bool) error {
+func ListRegexPatternSetsPages(conn wafiface.WAFAPI, input *waf.ListRegexPatternSetsInput, fn func(*waf.ListRegexPatternSetsOutput, bool) bool) error {
 	return ListRegexPatternSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListRegexPatternSetsInput, fn func(*waf.ListRegexPatternSetsOutput, bool) bool) error {
+func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListRegexPatternSetsInput, fn func(*waf.ListRegexPatternSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRegexPatternSetsWithContext(ctx, input)
 		if err != nil {
@@ -135,11 +136,11 @@ func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn *waf.WAF, in
 	return nil
 }
 
-func ListRuleGroupsPages(conn *waf.WAF, input *waf.ListRuleGroupsInput, fn func(*waf.ListRuleGroupsOutput, bool) bool) error {
+func ListRuleGroupsPages(conn wafiface.WAFAPI, input *waf.ListRuleGroupsInput, fn func(*waf.ListRuleGroupsOutput, bool) bool) error {
 	return ListRuleGroupsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListRuleGroupsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListRuleGroupsInput, fn func(*waf.ListRuleGroupsOutput, bool) bool) error {
+func ListRuleGroupsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListRuleGroupsInput, fn func(*waf.ListRuleGroupsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRuleGroupsWithContext(ctx, input)
 		if err != nil {
@@ -156,11 +157,11 @@ func ListRuleGroupsPagesWithContext(ctx context.Context, conn *waf.WAF, input *w
 	return nil
 }
 
-func ListRulesPages(conn *waf.WAF, input *waf.ListRulesInput, fn func(*waf.ListRulesOutput, bool) bool) error {
+func ListRulesPages(conn wafiface.WAFAPI, input *waf.ListRulesInput, fn func(*waf.ListRulesOutput, bool) bool) error {
 	return ListRulesPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListRulesPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListRulesInput, fn func(*waf.ListRulesOutput, bool) bool) error {
+func ListRulesPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListRulesInput, fn func(*waf.ListRulesOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRulesWithContext(ctx, input)
 		if err != nil {
@@ -177,11 +178,11 @@ func ListRulesPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.Li
 	return nil
 }
 
-func ListSizeConstraintSetsPages(conn *waf.WAF, input *waf.ListSizeConstraintSetsInput, fn func(*waf.ListSizeConstraintSetsOutput, bool) bool) error {
+func ListSizeConstraintSetsPages(conn wafiface.WAFAPI, input *waf.ListSizeConstraintSetsInput, fn func(*waf.ListSizeConstraintSetsOutput, bool) bool) error {
 	return ListSizeConstraintSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListSizeConstraintSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListSizeConstraintSetsInput, fn func(*waf.ListSizeConstraintSetsOutput, bool) bool) error {
+func ListSizeConstraintSetsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListSizeConstraintSetsInput, fn func(*waf.ListSizeConstraintSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListSizeConstraintSetsWithContext(ctx, input)
 		if err != nil {
@@ -198,11 +199,11 @@ func ListSizeConstraintSetsPagesWithContext(ctx context.Context, conn *waf.WAF,
 	return nil
 }
 
-func ListSqlInjectionMatchSetsPages(conn *waf.WAF, input *waf.ListSqlInjectionMatchSetsInput, fn func(*waf.ListSqlInjectionMatchSetsOutput, bool) bool) error {
+func ListSqlInjectionMatchSetsPages(conn wafiface.WAFAPI, input *waf.ListSqlInjectionMatchSetsInput, fn func(*waf.ListSqlInjectionMatchSetsOutput, bool) bool) error {
 	return ListSqlInjectionMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListSqlInjectionMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListSqlInjectionMatchSetsInput, fn func(*waf.ListSqlInjectionMatchSetsOutput, bool) bool) error {
+func ListSqlInjectionMatchSetsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListSqlInjectionMatchSetsInput, fn func(*waf.ListSqlInjectionMatchSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListSqlInjectionMatchSetsWithContext(ctx, input)
 		if err != nil {
@@ -219,11 +220,11 @@ func ListSqlInjectionMatchSetsPagesWithContext(ctx context.Context, conn *waf.WA
 	return nil
 }
 
-func ListWebACLsPages(conn *waf.WAF, input *waf.ListWebACLsInput, fn func(*waf.ListWebACLsOutput, bool) bool) error {
+func ListWebACLsPages(conn wafiface.WAFAPI, input *waf.ListWebACLsInput, fn func(*waf.ListWebACLsOutput, bool) bool) error {
 	return ListWebACLsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListWebACLsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListWebACLsInput, fn func(*waf.ListWebACLsOutput, bool) bool) error {
+func ListWebACLsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListWebACLsInput, fn func(*waf.ListWebACLsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListWebACLsWithContext(ctx, input)
 		if err != nil {
@@ -240,11 +241,11 @@ func ListWebACLsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.
 	return nil
 }
 
-func ListXssMatchSetsPages(conn *waf.WAF, input *waf.ListXssMatchSetsInput, fn func(*waf.ListXssMatchSetsOutput, bool) bool) error {
+func ListXssMatchSetsPages(conn wafiface.WAFAPI, input *waf.ListXssMatchSetsInput, fn func(*waf.ListXssMatchSetsOutput, bool) bool) error {
 	return ListXssMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListXssMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.ListXssMatchSetsInput, fn func(*waf.ListXssMatchSetsOutput, bool) bool) error {
+func ListXssMatchSetsPagesWithContext(ctx context.Context, conn wafiface.WAFAPI, input *waf.ListXssMatchSetsInput, fn func(*waf.ListXssMatchSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListXssMatchSetsWithContext(ctx, input)
 		if err != nil {
@@ -0,0 +1,1394 @@
+// Code generated by "aws/internal/generators/listpages/main.go -function=ListByteMatchSets,ListGeoMatchSets,ListIPSets,ListRateBasedRules,ListRegexMatchSets,ListRegexPatternSets,ListRuleGroups,ListRules,ListSizeConstraintSets,ListSqlInjectionMatchSets,ListWebACLs,ListXssMatchSets -paginator=NextMarker github.com/aws/aws-sdk-go/service/waf"; DO NOT EDIT.
+
+package lister
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/waf/wafiface"
+)
+
+type fakeListByteMatchSetsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListByteMatchSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListByteMatchSetsClient) ListByteMatchSetsWithContext(ctx aws.Context, input *waf.ListByteMatchSetsInput, opts ...request.Option) (*waf.ListByteMatchSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListByteMatchSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListByteMatchSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListByteMatchSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListByteMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListByteMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListByteMatchSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListByteMatchSetsPages(conn, &waf.ListByteMatchSetsInput{}, func(page *waf.ListByteMatchSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListGeoMatchSetsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListGeoMatchSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListGeoMatchSetsClient) ListGeoMatchSetsWithContext(ctx aws.Context, input *waf.ListGeoMatchSetsInput, opts ...request.Option) (*waf.ListGeoMatchSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListGeoMatchSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListGeoMatchSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListGeoMatchSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListGeoMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListGeoMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListGeoMatchSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListGeoMatchSetsPages(conn, &waf.ListGeoMatchSetsInput{}, func(page *waf.ListGeoMatchSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListIPSetsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListIPSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListIPSetsClient) ListIPSetsWithContext(ctx aws.Context, input *waf.ListIPSetsInput, opts ...request.Option) (*waf.ListIPSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListIPSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListIPSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListIPSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListIPSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListIPSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListIPSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListIPSetsPages(conn, &waf.ListIPSetsInput{}, func(page *waf.ListIPSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListRateBasedRulesClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListRateBasedRulesOutput
+	tokens  []string
+}
+
+func (c *fakeListRateBasedRulesClient) ListRateBasedRulesWithContext(ctx aws.Context, input *waf.ListRateBasedRulesInput, opts ...request.Option) (*waf.ListRateBasedRulesOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListRateBasedRulesPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListRateBasedRulesOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListRateBasedRulesOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListRateBasedRulesOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListRateBasedRulesOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListRateBasedRulesClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListRateBasedRulesPages(conn, &waf.ListRateBasedRulesInput{}, func(page *waf.ListRateBasedRulesOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListRegexMatchSetsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListRegexMatchSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListRegexMatchSetsClient) ListRegexMatchSetsWithContext(ctx aws.Context, input *waf.ListRegexMatchSetsInput, opts ...request.Option) (*waf.ListRegexMatchSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListRegexMatchSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListRegexMatchSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListRegexMatchSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListRegexMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListRegexMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListRegexMatchSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListRegexMatchSetsPages(conn, &waf.ListRegexMatchSetsInput{}, func(page *waf.ListRegexMatchSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListRegexPatternSetsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListRegexPatternSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListRegexPatternSetsClient) ListRegexPatternSetsWithContext(ctx aws.Context, input *waf.ListRegexPatternSetsInput, opts ...request.Option) (*waf.ListRegexPatternSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListRegexPatternSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListRegexPatternSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListRegexPatternSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListRegexPatternSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListRegexPatternSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListRegexPatternSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListRegexPatternSetsPages(conn, &waf.ListRegexPatternSetsInput{}, func(page *waf.ListRegexPatternSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListRuleGroupsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListRuleGroupsOutput
+	tokens  []string
+}
+
+func (c *fakeListRuleGroupsClient) ListRuleGroupsWithContext(ctx aws.Context, input *waf.ListRuleGroupsInput, opts ...request.Option) (*waf.ListRuleGroupsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListRuleGroupsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListRuleGroupsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListRuleGroupsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListRuleGroupsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListRuleGroupsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListRuleGroupsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListRuleGroupsPages(conn, &waf.ListRuleGroupsInput{}, func(page *waf.ListRuleGroupsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListRulesClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListRulesOutput
+	tokens  []string
+}
+
+func (c *fakeListRulesClient) ListRulesWithContext(ctx aws.Context, input *waf.ListRulesInput, opts ...request.Option) (*waf.ListRulesOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListRulesPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListRulesOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListRulesOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListRulesOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListRulesOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListRulesClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListRulesPages(conn, &waf.ListRulesInput{}, func(page *waf.ListRulesOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListSizeConstraintSetsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListSizeConstraintSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListSizeConstraintSetsClient) ListSizeConstraintSetsWithContext(ctx aws.Context, input *waf.ListSizeConstraintSetsInput, opts ...request.Option) (*waf.ListSizeConstraintSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListSizeConstraintSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListSizeConstraintSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListSizeConstraintSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListSizeConstraintSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListSizeConstraintSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListSizeConstraintSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListSizeConstraintSetsPages(conn, &waf.ListSizeConstraintSetsInput{}, func(page *waf.ListSizeConstraintSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListSqlInjectionMatchSetsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListSqlInjectionMatchSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListSqlInjectionMatchSetsClient) ListSqlInjectionMatchSetsWithContext(ctx aws.Context, input *waf.ListSqlInjectionMatchSetsInput, opts ...request.Option) (*waf.ListSqlInjectionMatchSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListSqlInjectionMatchSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListSqlInjectionMatchSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListSqlInjectionMatchSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListSqlInjectionMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListSqlInjectionMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListSqlInjectionMatchSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListSqlInjectionMatchSetsPages(conn, &waf.ListSqlInjectionMatchSetsInput{}, func(page *waf.ListSqlInjectionMatchSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListWebACLsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListWebACLsOutput
+	tokens  []string
+}
+
+func (c *fakeListWebACLsClient) ListWebACLsWithContext(ctx aws.Context, input *waf.ListWebACLsInput, opts ...request.Option) (*waf.ListWebACLsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListWebACLsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListWebACLsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListWebACLsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListWebACLsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListWebACLsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListWebACLsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListWebACLsPages(conn, &waf.ListWebACLsInput{}, func(page *waf.ListWebACLsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListXssMatchSetsClient struct {
+	wafiface.WAFAPI
+
+	err     error
+	outputs []*waf.ListXssMatchSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListXssMatchSetsClient) ListXssMatchSetsWithContext(ctx aws.Context, input *waf.ListXssMatchSetsInput, opts ...request.Option) (*waf.ListXssMatchSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListXssMatchSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*waf.ListXssMatchSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*waf.ListXssMatchSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*waf.ListXssMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*waf.ListXssMatchSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListXssMatchSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListXssMatchSetsPages(conn, &waf.ListXssMatchSetsInput{}, func(page *waf.ListXssMatchSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
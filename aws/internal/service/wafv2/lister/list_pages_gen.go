@@ -7,13 +7,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 )
 
-func ListIPSetsPages(conn *wafv2.WAFV2, input *wafv2.ListIPSetsInput, fn func(*wafv2.ListIPSetsOutput, bool) bool) error {
+func ListIPSetsPages(conn wafv2iface.WAFV2API, input *wafv2.ListIPSetsInput, fn func(*wafv2.ListIPSetsOutput, bool) bool) error {
 	return ListIPSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListIPSetsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListIPSetsInput, fn func(*wafv2.ListIPSetsOutput, bool) bool) error {
+func ListIPSetsPagesWithContext(ctx context.Context, conn wafv2iface.WAFV2API, input *wafv2.ListIPSetsInput, fn func(*wafv2.ListIPSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListIPSetsWithContext(ctx, input)
 		if err != nil {
@@ -30,11 +31,11 @@ func ListIPSetsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *w
 	return nil
 }
 
-func ListRegexPatternSetsPages(conn *wafv2.WAFV2, input *wafv2.ListRegexPatternSetsInput, fn func(*wafv2.ListRegexPatternSetsOutput, bool) bool) error {
+func ListRegexPatternSetsPages(conn wafv2iface.WAFV2API, input *wafv2.ListRegexPatternSetsInput, fn func(*wafv2.ListRegexPatternSetsOutput, bool) bool) error {
 	return ListRegexPatternSetsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListRegexPatternSetsInput, fn func(*wafv2.ListRegexPatternSetsOutput, bool) bool) error {
+func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn wafv2iface.WAFV2API, input *wafv2.ListRegexPatternSetsInput, fn func(*wafv2.ListRegexPatternSetsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRegexPatternSetsWithContext(ctx, input)
 		if err != nil {
@@ -51,11 +52,11 @@ func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2
 	return nil
 }
 
-func ListRuleGroupsPages(conn *wafv2.WAFV2, input *wafv2.ListRuleGroupsInput, fn func(*wafv2.ListRuleGroupsOutput, bool) bool) error {
+func ListRuleGroupsPages(conn wafv2iface.WAFV2API, input *wafv2.ListRuleGroupsInput, fn func(*wafv2.ListRuleGroupsOutput, bool) bool) error {
 	return ListRuleGroupsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListRuleGroupsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListRuleGroupsInput, fn func(*wafv2.ListRuleGroupsOutput, bool) bool) error {
+func ListRuleGroupsPagesWithContext(ctx context.Context, conn wafv2iface.WAFV2API, input *wafv2.ListRuleGroupsInput, fn func(*wafv2.ListRuleGroupsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRuleGroupsWithContext(ctx, input)
 		if err != nil {
@@ -72,11 +73,11 @@ func ListRuleGroupsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, inpu
 	return nil
 }
 
-func ListWebACLsPages(conn *wafv2.WAFV2, input *wafv2.ListWebACLsInput, fn func(*wafv2.ListWebACLsOutput, bool) bool) error {
+func ListWebACLsPages(conn wafv2iface.WAFV2API, input *wafv2.ListWebACLsInput, fn func(*wafv2.ListWebACLsOutput, bool) bool) error {
 	return ListWebACLsPagesWithContext(context.Background(), conn, input, fn)
 }
 
-func ListWebACLsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListWebACLsInput, fn func(*wafv2.ListWebACLsOutput, bool) bool) error {
+func ListWebACLsPagesWithContext(ctx context.Context, conn wafv2iface.WAFV2API, input *wafv2.ListWebACLsInput, fn func(*wafv2.ListWebACLsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListWebACLsWithContext(ctx, input)
 		if err != nil {
@@ -0,0 +1,474 @@
+// Code generated by "aws/internal/generators/listpages/main.go -function=ListIPSets,ListRegexPatternSets,ListRuleGroups,ListWebACLs -paginator=NextMarker github.com/aws/aws-sdk-go/service/wafv2"; DO NOT EDIT.
+
+package lister
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+)
+
+type fakeListIPSetsClient struct {
+	wafv2iface.WAFV2API
+
+	err     error
+	outputs []*wafv2.ListIPSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListIPSetsClient) ListIPSetsWithContext(ctx aws.Context, input *wafv2.ListIPSetsInput, opts ...request.Option) (*wafv2.ListIPSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListIPSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*wafv2.ListIPSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*wafv2.ListIPSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*wafv2.ListIPSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*wafv2.ListIPSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListIPSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListIPSetsPages(conn, &wafv2.ListIPSetsInput{}, func(page *wafv2.ListIPSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListRegexPatternSetsClient struct {
+	wafv2iface.WAFV2API
+
+	err     error
+	outputs []*wafv2.ListRegexPatternSetsOutput
+	tokens  []string
+}
+
+func (c *fakeListRegexPatternSetsClient) ListRegexPatternSetsWithContext(ctx aws.Context, input *wafv2.ListRegexPatternSetsInput, opts ...request.Option) (*wafv2.ListRegexPatternSetsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListRegexPatternSetsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*wafv2.ListRegexPatternSetsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*wafv2.ListRegexPatternSetsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*wafv2.ListRegexPatternSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*wafv2.ListRegexPatternSetsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListRegexPatternSetsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListRegexPatternSetsPages(conn, &wafv2.ListRegexPatternSetsInput{}, func(page *wafv2.ListRegexPatternSetsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListRuleGroupsClient struct {
+	wafv2iface.WAFV2API
+
+	err     error
+	outputs []*wafv2.ListRuleGroupsOutput
+	tokens  []string
+}
+
+func (c *fakeListRuleGroupsClient) ListRuleGroupsWithContext(ctx aws.Context, input *wafv2.ListRuleGroupsInput, opts ...request.Option) (*wafv2.ListRuleGroupsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListRuleGroupsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*wafv2.ListRuleGroupsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*wafv2.ListRuleGroupsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*wafv2.ListRuleGroupsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*wafv2.ListRuleGroupsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListRuleGroupsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListRuleGroupsPages(conn, &wafv2.ListRuleGroupsInput{}, func(page *wafv2.ListRuleGroupsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
+
+type fakeListWebACLsClient struct {
+	wafv2iface.WAFV2API
+
+	err     error
+	outputs []*wafv2.ListWebACLsOutput
+	tokens  []string
+}
+
+func (c *fakeListWebACLsClient) ListWebACLsWithContext(ctx aws.Context, input *wafv2.ListWebACLsInput, opts ...request.Option) (*wafv2.ListWebACLsOutput, error) {
+	c.tokens = append(c.tokens, aws.StringValue(input.NextMarker))
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	output := c.outputs[0]
+	c.outputs = c.outputs[1:]
+
+	return output, nil
+}
+
+func TestListWebACLsPages(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Outputs          []*wafv2.ListWebACLsOutput
+		Err              error
+		MaxPages         int
+		ExpectedError    bool
+		ExpectedPages    int
+		ExpectedLastPage bool
+		ExpectedTokens   []string
+	}{
+		{
+			Name: "single page",
+			Outputs: []*wafv2.ListWebACLsOutput{
+				{},
+			},
+			ExpectedPages:    1,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{""},
+		},
+		{
+			Name: "multiple pages",
+			Outputs: []*wafv2.ListWebACLsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{
+					NextMarker: aws.String("token2"),
+				},
+				{},
+			},
+			ExpectedPages:    3,
+			ExpectedLastPage: true,
+			ExpectedTokens:   []string{"", "token1", "token2"},
+		},
+		{
+			Name: "stop iteration",
+			Outputs: []*wafv2.ListWebACLsOutput{
+				{
+					NextMarker: aws.String("token1"),
+				},
+				{},
+			},
+			MaxPages:       1,
+			ExpectedPages:  1,
+			ExpectedTokens: []string{""},
+		},
+		{
+			Name:           "error",
+			Err:            errors.New("test error"),
+			ExpectedError:  true,
+			ExpectedTokens: []string{""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &fakeListWebACLsClient{
+				err:     testCase.Err,
+				outputs: testCase.Outputs,
+			}
+
+			var pages int
+			var lastPage bool
+
+			err := ListWebACLsPages(conn, &wafv2.ListWebACLsInput{}, func(page *wafv2.ListWebACLsOutput, isLast bool) bool {
+				pages++
+				lastPage = isLast
+				return testCase.MaxPages == 0 || pages < testCase.MaxPages
+			})
+
+			if testCase.ExpectedError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectedError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if pages != testCase.ExpectedPages {
+				t.Errorf("got %d pages, expected %d", pages, testCase.ExpectedPages)
+			}
+
+			if lastPage != testCase.ExpectedLastPage {
+				t.Errorf("got last page %t, expected %t", lastPage, testCase.ExpectedLastPage)
+			}
+
+			if !reflect.DeepEqual(conn.tokens, testCase.ExpectedTokens) {
+				t.Errorf("got tokens %v, expected %v", conn.tokens, testCase.ExpectedTokens)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Retryable is a function that is used to decide if a function's error is retryable or not.
+// The error argument can be `nil`.
+// If the error is retryable, returns a bool value of `true` and an error (not necessarily the error passed as the argument).
+// If the error is not retryable, returns a bool value of `false` and either no error (success state) or an error (not necessarily the error passed as the argument).
+type Retryable func(error) (bool, error)
+
+// RetryOptions configures the delays between attempts.
+type RetryOptions struct {
+	// Delay is the time to wait before the first attempt.
+	Delay time.Duration
+
+	// MinTimeout is the smallest time to wait between attempts.
+	// The time between attempts doubles after each attempt, up to 10 seconds.
+	MinTimeout time.Duration
+
+	// PollInterval, if set, is the fixed time to wait between attempts,
+	// overriding the exponential backoff.
+	PollInterval time.Duration
+}
+
+// RetryOptionsFunc modifies the default RetryOptions.
+type RetryOptionsFunc func(*RetryOptions)
+
+// WithDelay sets the time to wait before the first attempt.
+func WithDelay(delay time.Duration) RetryOptionsFunc {
+	return func(o *RetryOptions) {
+		o.Delay = delay
+	}
+}
+
+// WithMinTimeout sets the smallest time to wait between attempts.
+func WithMinTimeout(minTimeout time.Duration) RetryOptionsFunc {
+	return func(o *RetryOptions) {
+		o.MinTimeout = minTimeout
+	}
+}
+
+// WithPollInterval sets a fixed time to wait between attempts.
+func WithPollInterval(pollInterval time.Duration) RetryOptionsFunc {
+	return func(o *RetryOptions) {
+		o.PollInterval = pollInterval
+	}
+}
+
+const (
+	retryStateRetryable = "retryableerror"
+	retryStateSuccess   = "success"
+)
+
+var errStillExists = errors.New("resource still exists")
+
+// RetryWhenContext retries the function `f` when the error it returns satisfies `retryable`.
+// `f` is retried until `timeout` elapses or `ctx` is done.
+// If `timeout` elapses before an attempt of `f` completes, `f` is called a final time.
+// This replaces the resource.Retry and TimedOut pattern duplicated across resources.
+func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable, optFns ...RetryOptionsFunc) (interface{}, error) {
+	options := RetryOptions{
+		// Matches resource.Retry.
+		MinTimeout: 500 * time.Millisecond,
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	// An attempt may still be running when the timeout elapses; need a mutex
+	// to avoid a data race on its output.
+	var output interface{}
+	var outputMu sync.Mutex
+
+	err := retryContext(ctx, timeout, options, func() *resource.RetryError {
+		o, err := f()
+
+		outputMu.Lock()
+		output = o
+		outputMu.Unlock()
+
+		retry, err := retryable(err)
+
+		if retry {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if TimedOut(err) {
+		o, err := f()
+
+		if _, err := retryable(err); err != nil {
+			return nil, err
+		}
+
+		return o, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputMu.Lock()
+	defer outputMu.Unlock()
+
+	return output, nil
+}
+
+// RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
+// See RetryWhenContext.
+func RetryWhen(timeout time.Duration, f func() (interface{}, error), retryable Retryable, optFns ...RetryOptionsFunc) (interface{}, error) {
+	return RetryWhenContext(context.Background(), timeout, f, retryable, optFns...)
+}
+
+// RetryWhenAwsErrCodeEqualsContext retries the function `f` when it returns an AWS error with any of the specified codes.
+func RetryWhenAwsErrCodeEqualsContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		for _, code := range codes {
+			if tfawserr.ErrCodeEquals(err, code) {
+				return true, err
+			}
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenAwsErrCodeEquals retries the function `f` when it returns an AWS error with any of the specified codes.
+func RetryWhenAwsErrCodeEquals(timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) {
+	return RetryWhenAwsErrCodeEqualsContext(context.Background(), timeout, f, codes...)
+}
+
+// RetryWhenAwsErrMessageContainsContext retries the function `f` when it returns an AWS error with the specified code and a message containing the specified text.
+func RetryWhenAwsErrMessageContainsContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), code, message string) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		if tfawserr.ErrMessageContains(err, code, message) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenAwsErrMessageContains retries the function `f` when it returns an AWS error with the specified code and a message containing the specified text.
+func RetryWhenAwsErrMessageContains(timeout time.Duration, f func() (interface{}, error), code, message string) (interface{}, error) {
+	return RetryWhenAwsErrMessageContainsContext(context.Background(), timeout, f, code, message)
+}
+
+// RetryWhenNotFoundContext retries the function `f` when it returns a "not found" error.
+// It is typically used to wait for eventually consistent APIs, such as IAM, to return a newly created resource.
+func RetryWhenNotFoundContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		if NotFound(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenNotFound retries the function `f` when it returns a "not found" error.
+func RetryWhenNotFound(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhenNotFoundContext(context.Background(), timeout, f)
+}
+
+// RetryWhenNewResourceNotFoundContext retries the function `f` when it returns a "not found" error, and `isNewResource` is true.
+// It is typically called from a resource Read function with d.IsNewResource().
+func RetryWhenNewResourceNotFoundContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		if isNewResource && NotFound(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenNewResourceNotFound retries the function `f` when it returns a "not found" error, and `isNewResource` is true.
+func RetryWhenNewResourceNotFound(timeout time.Duration, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
+	return RetryWhenNewResourceNotFoundContext(context.Background(), timeout, f, isNewResource)
+}
+
+// RetryUntilNotFoundContext retries the function `f` until it returns a "not found" error.
+// Any other error stops the retries and is returned.
+func RetryUntilNotFoundContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		if NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return true, errStillExists
+	})
+}
+
+// RetryUntilNotFound retries the function `f` until it returns a "not found" error.
+func RetryUntilNotFound(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryUntilNotFoundContext(context.Background(), timeout, f)
+}
+
+// retryContext is resource.RetryContext with configurable delays.
+func retryContext(ctx context.Context, timeout time.Duration, options RetryOptions, f resource.RetryFunc) error {
+	// These are used to pull the error out of the function; need a mutex to
+	// avoid a data race.
+	var resultErr error
+	var resultErrMu sync.Mutex
+
+	c := &resource.StateChangeConf{
+		Pending:      []string{retryStateRetryable},
+		Target:       []string{retryStateSuccess},
+		Timeout:      timeout,
+		Delay:        options.Delay,
+		MinTimeout:   options.MinTimeout,
+		PollInterval: options.PollInterval,
+		Refresh: func() (interface{}, string, error) {
+			rerr := f()
+
+			resultErrMu.Lock()
+			defer resultErrMu.Unlock()
+
+			if rerr == nil {
+				resultErr = nil
+				return 42, retryStateSuccess, nil
+			}
+
+			resultErr = rerr.Err
+
+			if rerr.Retryable {
+				return 42, retryStateRetryable, nil
+			}
+
+			return nil, "quit", rerr.Err
+		},
+	}
+
+	_, waitErr := c.WaitForStateContext(ctx)
+
+	// Need to acquire the lock here to be able to avoid race using resultErr as
+	// the return value.
+	resultErrMu.Lock()
+	defer resultErrMu.Unlock()
+
+	// resultErr may be nil because the wait timed out and resultErr was never set;
+	// this is still an error.
+	if resultErr == nil {
+		return waitErr
+	}
+
+	// resultErr takes precedence over waitErr if both are set because it is
+	// more likely to be useful.
+	return resultErr
+}
@@ -0,0 +1,301 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRetryWhenAwsErrCodeEquals(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable other error",
+			F: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("Testing", "Testing", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "TestMessage", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("TestCode2", "TestMessage", nil)
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := RetryWhenAwsErrCodeEquals(2*time.Second, testCase.F, "TestCode1", "TestCode2")
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestRetryWhenAwsErrMessageContains(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "Testing", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("TestCode1", "is not authorized", nil)
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := RetryWhenAwsErrMessageContains(2*time.Second, testCase.F, "TestCode1", "is not authorized")
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestRetryWhenNewResourceNotFound(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name          string
+		F             func() (interface{}, error)
+		NewResource   bool
+		ExpectError   bool
+		ExpectRetries int32
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+			NewResource: true,
+		},
+		{
+			Name: "not found error not new resource",
+			F: func() (interface{}, error) {
+				atomic.AddInt32(&retryCount, 1)
+				return nil, &resource.NotFoundError{}
+			},
+			ExpectError:   true,
+			ExpectRetries: 1,
+		},
+		{
+			Name: "not found error new resource success",
+			F: func() (interface{}, error) {
+				if atomic.AddInt32(&retryCount, 1) == 1 {
+					return nil, &resource.NotFoundError{}
+				}
+
+				return 42, nil
+			},
+			NewResource:   true,
+			ExpectRetries: 2,
+		},
+		{
+			Name: "other error new resource",
+			F: func() (interface{}, error) {
+				atomic.AddInt32(&retryCount, 1)
+				return nil, errors.New("test")
+			},
+			NewResource:   true,
+			ExpectError:   true,
+			ExpectRetries: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := RetryWhenNewResourceNotFound(2*time.Second, testCase.F, testCase.NewResource)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := atomic.LoadInt32(&retryCount); got != testCase.ExpectRetries {
+				t.Errorf("got %d attempts, expected %d", got, testCase.ExpectRetries)
+			}
+		})
+	}
+}
+
+func TestRetryUntilNotFound(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "not found error",
+			F: func() (interface{}, error) {
+				return nil, &resource.NotFoundError{}
+			},
+		},
+		{
+			Name: "other error",
+			F: func() (interface{}, error) {
+				return nil, errors.New("test")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "found then not found",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return 42, nil
+				}
+
+				return nil, &resource.NotFoundError{}
+			},
+		},
+		{
+			Name: "found timeout",
+			F: func() (interface{}, error) {
+				return 42, nil
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := RetryUntilNotFound(2*time.Second, testCase.F)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestRetryWhenSlowAttempt(t *testing.T) {
+	var attempts int32
+
+	output, err := RetryWhen(100*time.Millisecond, func() (interface{}, error) {
+		// The first attempt does not complete before the timeout,
+		// but does complete within the refresh grace period.
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			time.Sleep(300 * time.Millisecond)
+			return 42, nil
+		}
+
+		return nil, errors.New("test")
+	}, func(err error) (bool, error) {
+		return false, err
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if output != 42 {
+		t.Errorf("got output %v, expected 42", output)
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("got %d attempts, expected 1", got)
+	}
+}
+
+func TestRetryWhenContextCancelled(t *testing.T) {
+	var attempts int32
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := RetryWhenContext(ctx, 5*time.Second, func() (interface{}, error) {
+		atomic.AddInt32(&attempts, 1)
+		return nil, errors.New("test")
+	}, func(err error) (bool, error) {
+		return true, err
+	}, WithPollInterval(10*time.Millisecond))
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if TimedOut(err) {
+		t.Errorf("expected context error to not be treated as a timeout: %s", err)
+	}
+
+	if atomic.LoadInt32(&attempts) < 2 {
+		t.Errorf("expected multiple attempts, got %d", attempts)
+	}
+}
@@ -213,7 +213,8 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		"tags": tagsSchema(),
+		"tags":     tagsSchema(),
+		"tags_all": tagsSchemaTrulyComputed(),
 	}
 
 	if lt.CustomShortName {
@@ -247,23 +248,23 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 
 	return &schema.Resource{
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 			return lt.Read(d, client, ignoreTagsConfig)
 		},
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			return lt.Create(d, client, meta)
 		},
 		Update: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 			return lt.Update(d, client, ignoreTagsConfig)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			return lt.Delete(d, client)
 		},
 		Importer: &schema.ResourceImporter{
@@ -501,8 +502,8 @@ func (lt *opsworksLayerType) Update(d *schema.ResourceData, client *opsworks.Ops
 		return err
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		arn := d.Get("arn").(string)
 		if err := keyvaluetags.OpsworksUpdateTags(client, arn, o, n); err != nil {
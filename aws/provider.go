@@ -2,10 +2,15 @@ package aws
 
 import (
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retrypolicy"
 )
 
 // Provider returns a *schema.Provider.
@@ -39,6 +44,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -71,6 +78,8 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_policy": retryPolicySchema(),
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -87,8 +96,34 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to default resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to default across all resources",
+						},
+					},
+				},
+			},
+
+			"emulator_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  descriptions["emulator_endpoint"],
+			},
+
 			"endpoints": endpointsSchema(),
 
+			"global_service": globalServiceSchema(),
+
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -121,6 +156,26 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"http_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				Description:  descriptions["http_proxy"],
+			},
+
+			"min_tls_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+				Description:  descriptions["min_tls_version"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -162,6 +217,50 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to check resource tags against a tag policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_file": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"tag_policy.0.target_id"},
+							Description:   "Path of a JSON tag policy. If not set, the effective tag policy is loaded from AWS Organizations.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys required on all resources.",
+						},
+						"target_id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"tag_policy.0.policy_file"},
+							Description:   "AWS Organizations account ID to load the effective tag policy for. Defaults to the provider account.",
+						},
+					},
+				},
+			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -265,6 +364,7 @@ func Provider() *schema.Provider {
 			"aws_iam_instance_profile":                       dataSourceAwsIAMInstanceProfile(),
 			"aws_iam_policy":                                 dataSourceAwsIAMPolicy(),
 			"aws_iam_policy_document":                        dataSourceAwsIamPolicyDocument(),
+			"aws_iam_principal_policy_simulation":            dataSourceAwsIAMPrincipalPolicySimulation(),
 			"aws_iam_role":                                   dataSourceAwsIAMRole(),
 			"aws_iam_server_certificate":                     dataSourceAwsIAMServerCertificate(),
 			"aws_iam_user":                                   dataSourceAwsIAMUser(),
@@ -332,6 +432,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_service_account":                   dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                                     dataSourceAwsRegion(),
 			"aws_regions":                                    dataSourceAwsRegions(),
+			"aws_resourcegroupstaggingapi_resources":         dataSourceAwsResourceGroupsTaggingAPIResources(),
 			"aws_route":                                      dataSourceAwsRoute(),
 			"aws_route_table":                                dataSourceAwsRouteTable(),
 			"aws_route_tables":                               dataSourceAwsRouteTables(),
@@ -858,6 +959,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_snapshot_schedule":                          resourceAwsRedshiftSnapshotSchedule(),
 			"aws_redshift_snapshot_schedule_association":              resourceAwsRedshiftSnapshotScheduleAssociation(),
 			"aws_redshift_event_subscription":                         resourceAwsRedshiftEventSubscription(),
+			"aws_resource_tags":                                       resourceAwsResourceTags(),
 			"aws_resourcegroups_group":                                resourceAwsResourceGroupsGroup(),
 			"aws_route53_delegation_set":                              resourceAwsRoute53DelegationSet(),
 			"aws_route53_query_log":                                   resourceAwsRoute53QueryLog(),
@@ -1081,6 +1183,23 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = dataSourceAwsServerlessApplicationRepositoryApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = resourceAwsServerlessApplicationRepositoryCloudFormationStack()
 
+	// Apply any default tags to, and check the tags against any tag policy at plan time of, resources with tags.
+	for _, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; ok {
+			resourceWithDefaultTags(r)
+		}
+
+		if v, ok := r.Schema["tags"]; !ok || v.Type != schema.TypeMap || !v.Optional {
+			continue
+		}
+
+		if r.CustomizeDiff == nil {
+			r.CustomizeDiff = CheckTagPolicyDiff
+		} else {
+			r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, CheckTagPolicyDiff)
+		}
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1121,11 +1240,30 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"emulator_endpoint": "Base URL of a local AWS emulator to use for every service without an endpoint\n" +
+			"override. Enables path-style S3 addressing and skips credentials validation,\n" +
+			"account ID lookup and metadata API checks.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"global_service": "Configuration block routing a global service to a region and, optionally,\n" +
+			"an endpoint in a partition, overriding the provider defaults.",
+
+		"retry_policy": "Configuration block with client-side request rate limit, concurrency limit and retry settings\n" +
+			"for a service. May be specified once per service.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
+		"custom_ca_bundle": "File path to a PEM encoded bundle of certificate authorities used to verify " +
+			"the TLS certificates of AWS API endpoints, instead of the system certificate pool.",
+
+		"http_proxy": "URL of an HTTP proxy to use for AWS API requests, instead of the proxy " +
+			"environment variables.",
+
+		"min_tls_version": "Minimum TLS version to negotiate with AWS API endpoints. " +
+			"Valid values are `1.0`, `1.1`, `1.2` and `1.3`.",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -1145,6 +1283,12 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability (IPv4 and IPv6) " +
+			"for services that support it. Does not apply to services with a custom endpoint.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS 140-2 validated cryptography " +
+			"for every service. Does not apply to services with a custom endpoint.",
 	}
 
 	endpointServiceNames = []string{
@@ -1311,14 +1455,23 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		CredsFilename:           d.Get("shared_credentials_file").(string),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
+		EmulatorEndpoint:        d.Get("emulator_endpoint").(string),
+		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		RetryPolicies:           expandProviderRetryPolicies(d.Get("retry_policy").([]interface{})),
+		GlobalServices:          expandProviderGlobalServices(d.Get("global_service").([]interface{})),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
+		HTTPProxy:               d.Get("http_proxy").(string),
 		Insecure:                d.Get("insecure").(bool),
+		MinTLSVersion:           d.Get("min_tls_version").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		UseDualStackEndpoint:    d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:         d.Get("use_fips_endpoint").(bool),
 		terraformVersion:        terraformVersion,
 	}
 
@@ -1386,6 +1539,76 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
+		}
+
+		if v, ok := m["policy"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityPolicy = v
+		}
+
+		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+			for _, policyARNRaw := range policyARNSet.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityRoleARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityRoleARN, config.AssumeRoleWithWebIdentitySessionName)
+	}
+
+	if l, ok := d.Get("tag_policy").([]interface{}); ok && len(l) > 0 {
+		config.UseTagPolicy = true
+
+		if m, ok := l[0].(map[string]interface{}); ok {
+			if v, ok := m["policy_file"].(string); ok && v != "" {
+				config.TagPolicyFile = v
+			}
+
+			if requiredKeySet, ok := m["required_keys"].(*schema.Set); ok && requiredKeySet.Len() > 0 {
+				for _, requiredKeyRaw := range requiredKeySet.List() {
+					requiredKey, ok := requiredKeyRaw.(string)
+
+					if !ok {
+						continue
+					}
+
+					config.TagPolicyRequiredKeys = append(config.TagPolicyRequiredKeys, requiredKey)
+				}
+			}
+
+			if v, ok := m["target_id"].(string); ok && v != "" {
+				config.TagPolicyTargetID = v
+			}
+		}
+
+		log.Printf("[INFO] tag_policy configuration set: (File: %q, TargetID: %q)", config.TagPolicyFile, config.TagPolicyTargetID)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1410,8 +1633,12 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 	return config.Client()
 }
 
+// awsMutexKVDebugThreshold is the time a lock in awsMutexKV can be held
+// before the holder and wait time are logged, to diagnose apparent hangs.
+const awsMutexKVDebugThreshold = 1 * time.Minute
+
 // This is a global MutexKV for use within this plugin.
-var awsMutexKV = mutexkv.NewMutexKV()
+var awsMutexKV = mutexkv.NewMutexKV(mutexkv.WithDebugThreshold(awsMutexKVDebugThreshold))
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
@@ -1468,6 +1695,164 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  "Seconds to restrict the assume role session duration.",
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsJSON,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateArn,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: tfarn.IAMRole.ValidateFunc(),
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+				},
+				"session_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(2, 64),
+					Description:  "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+					Description:  "Path to a file containing an OAuth 2.0 access token or OpenID Connect ID token. The file is read when the provider is configured.",
+				},
+			},
+		},
+	}
+}
+
+func retryPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["retry_policy"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of requests allowed in a single burst. Defaults to `requests_per_second`.",
+				},
+				"concurrency_limit": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration block with a limit on the number of concurrent requests made by the provider to the service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_concurrent": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "Maximum number of concurrent requests.",
+							},
+							"operations": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Set:         schema.HashString,
+								Description: "API operation names the limit applies to. Defaults to all create, update and delete operations.",
+							},
+						},
+					},
+				},
+				"max_backoff_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of seconds to wait between retries of a request.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "Maximum number of requests per second made by the provider to the service.",
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: "AWS error codes which should always be retried.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+					Description:  "Service name, matching the keys of the `endpoints` configuration block.",
+				},
+			},
+		},
+	}
+}
+
+func globalServiceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["global_service"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"endpoint": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "Endpoint URL of the service in the partition.",
+				},
+				"partition": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Partition identifier, e.g. `aws` or `aws-iso`. If omitted, applies to all partitions.",
+				},
+				"region": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+					Description:  "Region to send requests to and sign requests for.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+					Description:  "Service name, matching the keys of the `endpoints` configuration block.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1489,6 +1874,126 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &keyvaluetags.DefaultConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		defaultConfig.Tags = keyvaluetags.New(v)
+	}
+
+	return defaultConfig
+}
+
+func expandProviderGlobalServices(l []interface{}) []*GlobalServiceConfig {
+	var globalServices []*GlobalServiceConfig
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		globalService := &GlobalServiceConfig{}
+
+		if v, ok := tfMap["endpoint"].(string); ok {
+			globalService.Endpoint = v
+		}
+
+		if v, ok := tfMap["partition"].(string); ok {
+			globalService.Partition = v
+		}
+
+		if v, ok := tfMap["region"].(string); ok {
+			globalService.Region = v
+		}
+
+		if v, ok := tfMap["service"].(string); ok {
+			globalService.Service = v
+		}
+
+		globalServices = append(globalServices, globalService)
+	}
+
+	return globalServices
+}
+
+func expandProviderRetryPolicies(l []interface{}) map[string]*retrypolicy.Policy {
+	if len(l) == 0 {
+		return nil
+	}
+
+	policies := make(map[string]*retrypolicy.Policy)
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		policy := &retrypolicy.Policy{}
+
+		if v, ok := tfMap["burst"].(int); ok && v != 0 {
+			policy.Burst = v
+		}
+
+		if v, ok := tfMap["concurrency_limit"].([]interface{}); ok {
+			policy.ConcurrencyLimits = expandProviderConcurrencyLimits(v)
+		}
+
+		if v, ok := tfMap["max_backoff_seconds"].(int); ok && v != 0 {
+			policy.MaxBackoff = time.Duration(v) * time.Second
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok && v != 0 {
+			policy.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok {
+			for _, code := range v.List() {
+				policy.RetryableErrors = append(policy.RetryableErrors, retrypolicy.RetryableError{Code: code.(string)})
+			}
+		}
+
+		service := tfMap["service"].(string)
+		policies[service] = policies[service].Merge(policy)
+	}
+
+	return policies
+}
+
+func expandProviderConcurrencyLimits(l []interface{}) []retrypolicy.ConcurrencyLimit {
+	var limits []retrypolicy.ConcurrencyLimit
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		limit := retrypolicy.ConcurrencyLimit{
+			MaxConcurrent: tfMap["max_concurrent"].(int),
+		}
+
+		if v, ok := tfMap["operations"].(*schema.Set); ok {
+			for _, operation := range v.List() {
+				limit.Operations = append(limit.Operations, operation.(string))
+			}
+		}
+
+		limits = append(limits, limit)
+	}
+
+	return limits
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
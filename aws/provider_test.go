@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -179,6 +180,142 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProvider_TagsAll(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if v, ok := r.Schema["tags"]; !ok || v.Type != schema.TypeMap || !v.Optional {
+			continue
+		}
+
+		v, ok := r.Schema["tags_all"]
+
+		if !ok {
+			t.Errorf("%s: tags without tags_all, default_tags are not applied", name)
+			continue
+		}
+
+		if v.Type != schema.TypeMap || !v.Computed || v.Optional || v.Required {
+			t.Errorf("%s: tags_all must be computed only, use tagsSchemaTrulyComputed()", name)
+		}
+
+		if r.CustomizeDiff == nil {
+			t.Errorf("%s: tags_all without CustomizeDiff, tags_all is not planned", name)
+		}
+	}
+}
+
+func TestExpandProviderRetryPolicies(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"retry_policy": retryPolicySchema(),
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"retry_policy": []interface{}{
+			map[string]interface{}{
+				"service":             "ec2",
+				"requests_per_second": 20.0,
+				"burst":               40,
+				"max_backoff_seconds": 30,
+				"retryable_error_codes": []interface{}{
+					"RequestLimitExceeded",
+				},
+			},
+			map[string]interface{}{
+				"service":             "route53",
+				"requests_per_second": 5.0,
+				"concurrency_limit": []interface{}{
+					map[string]interface{}{
+						"max_concurrent": 2,
+						"operations": []interface{}{
+							"ChangeResourceRecordSets",
+						},
+					},
+				},
+			},
+		},
+	})
+
+	policies := expandProviderRetryPolicies(d.Get("retry_policy").([]interface{}))
+
+	if got, expected := len(policies), 2; got != expected {
+		t.Fatalf("got %d policies, expected %d", got, expected)
+	}
+
+	ec2Policy := policies["ec2"]
+
+	if ec2Policy == nil {
+		t.Fatal("expected ec2 policy")
+	}
+
+	if ec2Policy.RequestsPerSecond != 20 || ec2Policy.Burst != 40 {
+		t.Errorf("got ec2 rate %f and burst %d, expected 20 and 40", ec2Policy.RequestsPerSecond, ec2Policy.Burst)
+	}
+
+	if got, expected := ec2Policy.MaxBackoff, 30*time.Second; got != expected {
+		t.Errorf("got ec2 max backoff %s, expected %s", got, expected)
+	}
+
+	if len(ec2Policy.RetryableErrors) != 1 || ec2Policy.RetryableErrors[0].Code != "RequestLimitExceeded" {
+		t.Errorf("got ec2 retryable errors %v, expected RequestLimitExceeded", ec2Policy.RetryableErrors)
+	}
+
+	route53Policy := policies["route53"]
+
+	if route53Policy == nil || route53Policy.RequestsPerSecond != 5 {
+		t.Fatalf("got route53 policy %v, expected 5 requests per second", route53Policy)
+	}
+
+	if len(route53Policy.ConcurrencyLimits) != 1 {
+		t.Fatalf("got %d route53 concurrency limits, expected 1", len(route53Policy.ConcurrencyLimits))
+	}
+
+	if limit := route53Policy.ConcurrencyLimits[0]; limit.MaxConcurrent != 2 || len(limit.Operations) != 1 || limit.Operations[0] != "ChangeResourceRecordSets" {
+		t.Errorf("got route53 concurrency limit %v, expected 2 concurrent ChangeResourceRecordSets", limit)
+	}
+
+	if got, expected := len(ec2Policy.ConcurrencyLimits), 0; got != expected {
+		t.Errorf("got %d ec2 concurrency limits, expected %d", got, expected)
+	}
+}
+
+func TestExpandProviderGlobalServices(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"global_service": globalServiceSchema(),
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"global_service": []interface{}{
+			map[string]interface{}{
+				"service":   "route53",
+				"partition": "aws-iso",
+				"region":    "us-iso-east-1",
+				"endpoint":  "https://route53.example.com",
+			},
+			map[string]interface{}{
+				"service": "organizations",
+				"region":  "us-east-1",
+			},
+		},
+	})
+
+	got := expandProviderGlobalServices(d.Get("global_service").([]interface{}))
+	expected := []*GlobalServiceConfig{
+		{
+			Endpoint:  "https://route53.example.com",
+			Partition: "aws-iso",
+			Region:    "us-iso-east-1",
+			Service:   "route53",
+		},
+		{
+			Region:  "us-east-1",
+			Service: "organizations",
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
+
 // testAccPreCheck verifies and sets required provider testing configuration
 //
 // This PreCheck function should be present in every acceptance test. It allows
@@ -744,7 +881,7 @@ func testAccPartitionPreCheck(partition string, t *testing.T) {
 }
 
 func testAccOrganizationsAccountPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -757,7 +894,7 @@ func testAccOrganizationsAccountPreCheck(t *testing.T) {
 }
 
 func testAccOrganizationsEnabledPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -769,7 +906,7 @@ func testAccOrganizationsEnabledPreCheck(t *testing.T) {
 }
 
 func testAccPreCheckIamServiceLinkedRole(t *testing.T, pathPrefix string) {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String(pathPrefix),
@@ -868,6 +1005,33 @@ func testAccMultipleRegionProviderConfig(regions int) string {
 	return config.String()
 }
 
+func testAccProviderConfigDefaultTags_Tags1(tag1, value1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %q = %q
+    }
+  }
+}
+`, tag1, value1)
+}
+
+func testAccProviderConfigDefaultTags_Tags2(tag1, value1, tag2, value2 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %q = %q
+      %q = %q
+    }
+  }
+}
+`, tag1, value1, tag2, value2)
+}
+
 func testAccProviderConfigIgnoreTagsKeyPrefixes1(keyPrefix1 string) string {
 	//lintignore:AT004
 	return fmt.Sprintf(`
@@ -1581,7 +1745,7 @@ func testAccPreCheckEc2ClassicOrHasDefaultVpcWithDefaultSubnets(t *testing.T) {
 
 // testAccHasDefaultVpc returns whether the current AWS region has a default VPC.
 func testAccHasDefaultVpc(t *testing.T) bool {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{
 		AttributeNames: aws.StringSlice([]string{ec2.AccountAttributeNameDefaultVpc}),
@@ -1601,7 +1765,7 @@ func testAccHasDefaultVpc(t *testing.T) bool {
 
 // testAccDefaultSubnetCount returns the number of default subnets in the current region's default VPC.
 func testAccDefaultSubnetCount(t *testing.T) int {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeSubnetsInput{
 		Filters: buildEC2AttributeFilterList(map[string]string{
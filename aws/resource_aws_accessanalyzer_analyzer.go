@@ -46,7 +46,9 @@ func resourceAwsAccessAnalyzerAnalyzer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,7 +64,7 @@ func resourceAwsAccessAnalyzerAnalyzer() *schema.Resource {
 }
 
 func resourceAwsAccessAnalyzerAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).accessanalyzerconn
+	conn := meta.(*AWSClient).accessanalyzerconn()
 	analyzerName := d.Get("analyzer_name").(string)
 
 	input := &accessanalyzer.CreateAnalyzerInput{
@@ -101,8 +103,8 @@ func resourceAwsAccessAnalyzerAnalyzerCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsAccessAnalyzerAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).accessanalyzerconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).accessanalyzerconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &accessanalyzer.GetAnalyzerInput{
 		AnalyzerName: aws.String(d.Id()),
@@ -137,10 +139,10 @@ func resourceAwsAccessAnalyzerAnalyzerRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAccessAnalyzerAnalyzerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).accessanalyzerconn
+	conn := meta.(*AWSClient).accessanalyzerconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.AccessanalyzerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating Access Analyzer Analyzer (%s) tags: %s", d.Id(), err)
 		}
@@ -150,7 +152,7 @@ func resourceAwsAccessAnalyzerAnalyzerUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsAccessAnalyzerAnalyzerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).accessanalyzerconn
+	conn := meta.(*AWSClient).accessanalyzerconn()
 
 	input := &accessanalyzer.DeleteAnalyzerInput{
 		AnalyzerName: aws.String(d.Id()),
@@ -32,7 +32,7 @@ func TestAccAWSAccessAnalyzer_serial(t *testing.T) {
 }
 
 func testAccPreCheckAWSAccessAnalyzer(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn
+	conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn()
 
 	input := &accessanalyzer.ListAnalyzersInput{}
 
@@ -163,7 +163,9 @@ func resourceAwsAcmCertificate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 			// Attempt to calculate the domain validation options based on domains present in domain_name and subject_alternative_names
@@ -224,7 +226,7 @@ func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsAcmCertificateCreateImported(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmconn
+	conn := meta.(*AWSClient).acmconn()
 
 	input := &acm.ImportCertificateInput{
 		Certificate: []byte(d.Get("certificate_body").(string)),
@@ -251,7 +253,7 @@ func resourceAwsAcmCertificateCreateImported(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 	params := &acm.RequestCertificateInput{
 		DomainName:       aws.String(d.Get("domain_name").(string)),
 		IdempotencyToken: aws.String(resource.PrefixedUniqueId("tf")), // 32 character limit
@@ -291,8 +293,8 @@ func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	acmconn := meta.(*AWSClient).acmconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	params := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(d.Id()),
@@ -364,7 +366,7 @@ func resourceAwsAcmCertificateValidationMethod(certificate *acm.CertificateDetai
 }
 
 func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmconn
+	conn := meta.(*AWSClient).acmconn()
 
 	if d.HasChanges("private_key", "certificate_body", "certificate_chain") {
 		// Prior to version 3.0.0 of the Terraform AWS Provider, these attributes were stored in state as hashes.
@@ -392,8 +394,8 @@ func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.AcmUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -453,7 +455,7 @@ func convertValidationOptions(certificate *acm.CertificateDetail) ([]map[string]
 }
 
 func resourceAwsAcmCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 
 	log.Printf("[INFO] Deleting ACM Certificate: %s", d.Id())
 
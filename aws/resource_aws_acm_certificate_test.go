@@ -29,7 +29,7 @@ func testSweepAcmCertificates(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).acmconn
+	conn := client.(*AWSClient).acmconn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListCertificatesPages(&acm.ListCertificatesInput{}, func(page *acm.ListCertificatesOutput, isLast bool) bool {
@@ -846,7 +846,7 @@ resource "aws_acm_certificate" "cert" {
 }
 
 func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
-	acmconn := testAccProvider.Meta().(*AWSClient).acmconn
+	acmconn := testAccProvider.Meta().(*AWSClient).acmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acm_certificate" {
@@ -255,7 +255,9 @@ func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 				Default:      30,
 				ValidateFunc: validation.IntBetween(7, 30),
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -271,7 +273,7 @@ func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 }
 
 func resourceAwsAcmpcaCertificateAuthorityCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().AcmpcaTags()
 
 	input := &acmpca.CreateCertificateAuthorityInput{
@@ -318,8 +320,8 @@ func resourceAwsAcmpcaCertificateAuthorityCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).acmpcaconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	certificateAuthority, err := finder.CertificateAuthorityByARN(conn, d.Id())
 
@@ -421,7 +423,7 @@ func resourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 	updateCertificateAuthority := false
 
 	input := &acmpca.UpdateCertificateAuthorityInput{
@@ -449,8 +451,8 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.AcmpcaUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating ACMPCA Certificate Authority (%s) tags: %s", d.Id(), err)
@@ -461,7 +463,7 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsAcmpcaCertificateAuthorityDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	input := &acmpca.DeleteCertificateAuthorityInput{
 		CertificateAuthorityArn: aws.String(d.Id()),
@@ -26,7 +26,7 @@ func testSweepAcmpcaCertificateAuthorities(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).acmpcaconn
+	conn := client.(*AWSClient).acmpcaconn()
 
 	certificateAuthorities, err := listAcmpcaCertificateAuthorities(conn)
 	if err != nil {
@@ -463,7 +463,7 @@ func TestAccAwsAcmpcaCertificateAuthority_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsAcmpcaCertificateAuthorityDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acmpca_certificate_authority" {
@@ -499,7 +499,7 @@ func testAccCheckAwsAcmpcaCertificateAuthorityExists(resourceName string, certif
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 		input := &acmpca.DescribeCertificateAuthorityInput{
 			CertificateAuthorityArn: aws.String(rs.Primary.ID),
 		}
@@ -522,7 +522,7 @@ func testAccCheckAwsAcmpcaCertificateAuthorityExists(resourceName string, certif
 
 func testAccCheckAwsAcmpcaCertificateAuthorityActivateCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 		arn := aws.StringValue(certificateAuthority.Arn)
 
@@ -579,7 +579,7 @@ func testAccCheckAwsAcmpcaCertificateAuthorityActivateCA(certificateAuthority *a
 
 func testAccCheckAwsAcmpcaCertificateAuthorityDisableCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 		_, err := conn.UpdateCertificateAuthority(&acmpca.UpdateCertificateAuthorityInput{
 			CertificateAuthorityArn: certificateAuthority.Arn,
@@ -210,7 +210,9 @@ func resourceAwsAmi() *schema.Resource {
 				ForceNew: true,
 				Default:  "simple",
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -230,7 +232,7 @@ func resourceAwsAmi() *schema.Resource {
 }
 
 func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.RegisterImageInput{
 		Name:               aws.String(d.Get("name").(string)),
@@ -305,8 +307,8 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	client := meta.(*AWSClient).ec2conn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	id := d.Id()
 
@@ -408,10 +410,10 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(client, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating AMI (%s) tags: %s", d.Id(), err)
@@ -434,7 +436,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DeregisterImageInput{
 		ImageId: aws.String(d.Id()),
@@ -181,7 +181,9 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -201,7 +203,7 @@ func resourceAwsAmiCopy() *schema.Resource {
 }
 
 func resourceAwsAmiCopyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.CopyImageInput{
 		Name:          aws.String(d.Get("name").(string)),
@@ -84,7 +84,7 @@ func testAccCheckAWSAMIFromInstanceExists(resourceName string, image *ec2.Image)
 			return fmt.Errorf("No ID set for %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DescribeImagesInput{
 			ImageIds: []*string{aws.String(rs.Primary.ID)},
@@ -105,7 +105,7 @@ func testAccCheckAWSAMIFromInstanceExists(resourceName string, image *ec2.Image)
 }
 
 func testAccCheckAWSAMIFromInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ami_from_instance" {
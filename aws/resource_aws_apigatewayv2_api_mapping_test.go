@@ -152,7 +152,7 @@ func testAccCheckAWSAPIGatewayV2ApiMappingCreateCertificate(rName string, certif
 		privateKey := tlsRsaPrivateKeyPem(2048)
 		certificate := tlsRsaX509SelfSignedCertificatePem(privateKey, fmt.Sprintf("%s.example.com", rName))
 
-		conn := testAccProvider.Meta().(*AWSClient).acmconn
+		conn := testAccProvider.Meta().(*AWSClient).acmconn()
 
 		output, err := conn.ImportCertificate(&acm.ImportCertificateInput{
 			Certificate: []byte(certificate),
@@ -172,7 +172,7 @@ func testAccCheckAWSAPIGatewayV2ApiMappingCreateCertificate(rName string, certif
 }
 
 func testAccCheckAWSAPIGatewayV2ApiMappingDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_api_mapping" {
@@ -198,7 +198,7 @@ func testAccCheckAWSAPIGatewayV2ApiMappingDestroy(s *terraform.State) error {
 
 func testAccCheckAWSAPIGatewayV2ApiMappingDisappears(domainName *string, v *apigatewayv2.GetApiMappingOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		_, err := conn.DeleteApiMapping(&apigatewayv2.DeleteApiMappingInput{
 			ApiMappingId: v.ApiMappingId,
@@ -220,7 +220,7 @@ func testAccCheckAWSAPIGatewayV2ApiMappingExists(n string, vDomainName *string,
 			return fmt.Errorf("No API Gateway v2 API mapping ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		domainName := aws.String(rs.Primary.Attributes["domain_name"])
 		resp, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
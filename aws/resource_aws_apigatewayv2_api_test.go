@@ -29,7 +29,7 @@ func testSweepAPIGatewayV2Apis(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).apigatewayv2conn
+	conn := client.(*AWSClient).apigatewayv2conn()
 	input := &apigatewayv2.GetApisInput{}
 	var sweeperErrs *multierror.Error
 
@@ -526,7 +526,7 @@ func TestAccAWSAPIGatewayV2Api_OpenapiWithMoreFields(t *testing.T) {
 
 func testAccCheckAWSAPIGatewayV2ApiRoutes(v *apigatewayv2.GetApiOutput, routes []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetRoutes(&apigatewayv2.GetRoutesInput{
 			ApiId: v.ApiId,
@@ -743,7 +743,7 @@ func TestAccAWSAPIGatewayV2Api_QuickCreate(t *testing.T) {
 }
 
 func testAccCheckAWSAPIGatewayV2ApiDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_api" {
@@ -777,7 +777,7 @@ func testAccCheckAWSAPIGatewayV2ApiExists(n string, v *apigatewayv2.GetApiOutput
 			return fmt.Errorf("No API Gateway v2 API ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetApi(&apigatewayv2.GetApiInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -803,7 +803,7 @@ func testAccCheckAWSAPIGatewayV2ApiQuickCreateIntegration(n, expectedType, expec
 			return fmt.Errorf("No API Gateway v2 API ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetIntegrations(&apigatewayv2.GetIntegrationsInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -838,7 +838,7 @@ func testAccCheckAWSAPIGatewayV2ApiQuickCreateRoute(n, expectedRouteKey string)
 			return fmt.Errorf("No API Gateway v2 API ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetRoutes(&apigatewayv2.GetRoutesInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -870,7 +870,7 @@ func testAccCheckAWSAPIGatewayV2ApiQuickCreateStage(n, expectedName string) reso
 			return fmt.Errorf("No API Gateway v2 API ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetStages(&apigatewayv2.GetStagesInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -329,7 +329,7 @@ func TestAccAWSAPIGatewayV2Authorizer_HttpApiLambdaRequestAuthorizer_InitialZero
 }
 
 func testAccCheckAWSAPIGatewayV2AuthorizerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_authorizer" {
@@ -364,7 +364,7 @@ func testAccCheckAWSAPIGatewayV2AuthorizerExists(n string, vApiId *string, v *ap
 			return fmt.Errorf("No API Gateway v2 authorizer ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		apiId := aws.String(rs.Primary.Attributes["api_id"])
 		resp, err := conn.GetAuthorizer(&apigatewayv2.GetAuthorizerInput{
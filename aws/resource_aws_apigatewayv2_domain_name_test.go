@@ -26,7 +26,7 @@ func testSweepAPIGatewayV2DomainNames(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).apigatewayv2conn
+	conn := client.(*AWSClient).apigatewayv2conn()
 	input := &apigatewayv2.GetDomainNamesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -338,7 +338,7 @@ func TestAccAWSAPIGatewayV2DomainName_MutualTlsAuthentication(t *testing.T) {
 }
 
 func testAccCheckAWSAPIGatewayV2DomainNameDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_domain_name" {
@@ -372,7 +372,7 @@ func testAccCheckAWSAPIGatewayV2DomainNameExists(n string, v *apigatewayv2.GetDo
 			return fmt.Errorf("No API Gateway v2 domain name ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
 			DomainName: aws.String(rs.Primary.ID),
@@ -103,7 +103,7 @@ func TestAccAWSAPIGatewayV2RouteResponse_Model(t *testing.T) {
 }
 
 func testAccCheckAWSAPIGatewayV2RouteResponseDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_route_response" {
@@ -130,7 +130,7 @@ func testAccCheckAWSAPIGatewayV2RouteResponseDestroy(s *terraform.State) error {
 
 func testAccCheckAWSAPIGatewayV2RouteResponseDisappears(apiId, routeId *string, v *apigatewayv2.GetRouteResponseOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		_, err := conn.DeleteRouteResponse(&apigatewayv2.DeleteRouteResponseInput{
 			ApiId:           apiId,
@@ -153,7 +153,7 @@ func testAccCheckAWSAPIGatewayV2RouteResponseExists(n string, vApiId, vRouteId *
 			return fmt.Errorf("No API Gateway v2 route response ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		apiId := aws.String(rs.Primary.Attributes["api_id"])
 		routeId := aws.String(rs.Primary.Attributes["route_id"])
@@ -1059,7 +1059,7 @@ func TestAccAWSAPIGatewayV2Stage_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSAPIGatewayV2StageDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_stage" {
@@ -1094,7 +1094,7 @@ func testAccCheckAWSAPIGatewayV2StageExists(n string, vApiId *string, v *apigate
 			return fmt.Errorf("No API Gateway v2 stage ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		apiId := aws.String(rs.Primary.Attributes["api_id"])
 		resp, err := conn.GetStage(&apigatewayv2.GetStageInput{
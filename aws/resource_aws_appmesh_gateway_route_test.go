@@ -26,7 +26,7 @@ func testSweepAppmeshGatewayRoutes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
+	conn := client.(*AWSClient).appmeshconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -445,7 +445,7 @@ func testAccAwsAppmeshGatewayRouteImportStateIdFunc(resourceName string) resourc
 }
 
 func testAccCheckAppmeshGatewayRouteDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_gateway_route" {
@@ -467,7 +467,7 @@ func testAccCheckAppmeshGatewayRouteDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshGatewayRouteExists(name string, v *appmesh.GatewayRouteData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -111,7 +111,7 @@ func TestAccAWSAppsyncApiKey_Expires(t *testing.T) {
 }
 
 func testAccCheckAwsAppsyncApiKeyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_api_key" {
 			continue
@@ -153,7 +153,7 @@ func testAccCheckAwsAppsyncApiKeyExists(resourceName string, apiKey *appsync.Api
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 		key, err := getAppsyncApiKey(apiID, keyID, conn)
 		if err != nil {
 			return err
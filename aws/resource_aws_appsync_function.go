@@ -77,7 +77,7 @@ func resourceAwsAppsyncFunction() *schema.Resource {
 }
 
 func resourceAwsAppsyncFunctionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID := d.Get("api_id").(string)
 
@@ -108,7 +108,7 @@ func resourceAwsAppsyncFunctionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAppsyncFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, functionID, err := decodeAppsyncFunctionID(d.Id())
 	if err != nil {
@@ -144,7 +144,7 @@ func resourceAwsAppsyncFunctionRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsAppsyncFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, functionID, err := decodeAppsyncFunctionID(d.Id())
 	if err != nil {
@@ -182,7 +182,7 @@ func resourceAwsAppsyncFunctionUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAppsyncFunctionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, functionID, err := decodeAppsyncFunctionID(d.Id())
 	if err != nil {
@@ -25,7 +25,7 @@ func testSweepAppsyncGraphqlApis(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appsyncconn
+	conn := client.(*AWSClient).appsyncconn()
 
 	input := &appsync.ListGraphqlApisInput{}
 
@@ -943,7 +943,7 @@ func TestAccAWSAppsyncGraphqlApi_XrayEnabled(t *testing.T) {
 }
 
 func testAccCheckAwsAppsyncGraphqlApiDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_graphql_api" {
 			continue
@@ -971,7 +971,7 @@ func testAccCheckAwsAppsyncGraphqlApiExists(name string, api *appsync.GraphqlApi
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 
 		input := &appsync.GetGraphqlApiInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -996,7 +996,7 @@ func testAccCheckAwsAppsyncTypeExists(name, typeName string) resource.TestCheckF
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 
 		input := &appsync.GetTypeInput{
 			ApiId:    aws.String(rs.Primary.ID),
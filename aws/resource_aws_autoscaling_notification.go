@@ -42,7 +42,7 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 }
 
 func resourceAwsAutoscalingNotificationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	gl := expandStringSet(d.Get("group_names").(*schema.Set))
 	nl := expandStringSet(d.Get("notifications").(*schema.Set))
 
@@ -58,7 +58,7 @@ func resourceAwsAutoscalingNotificationCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsAutoscalingNotificationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	gl := expandStringSet(d.Get("group_names").(*schema.Set))
 
 	opts := &autoscaling.DescribeNotificationConfigurationsInput{
@@ -117,7 +117,7 @@ func resourceAwsAutoscalingNotificationRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsAutoscalingNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	// Notifications API call is a PUT, so we don't need to diff the list, just
 	// push whatever it is and AWS sorts it out
@@ -189,7 +189,7 @@ func removeNotificationConfigToGroupsWithTopic(conn *autoscaling.AutoScaling, gr
 }
 
 func resourceAwsAutoscalingNotificationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	gl := expandStringSet(d.Get("group_names").(*schema.Set))
 
@@ -57,7 +57,7 @@ func TestAccAwsBackupGlobalSettings_basic(t *testing.T) {
 func testAccCheckAwsBackupGlobalSettingsExists(settings *backup.DescribeGlobalSettingsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 		resp, err := conn.DescribeGlobalSettings(&backup.DescribeGlobalSettingsInput{})
 		if err != nil {
 			return err
@@ -580,7 +580,7 @@ func TestAccAwsBackupPlan_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsBackupPlanDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).backupconn
+	conn := testAccProvider.Meta().(*AWSClient).backupconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_plan" {
 			continue
@@ -604,7 +604,7 @@ func testAccCheckAwsBackupPlanDestroy(s *terraform.State) error {
 
 func testAccCheckAwsBackupPlanExists(name string, plan *backup.GetBackupPlanOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
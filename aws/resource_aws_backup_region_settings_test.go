@@ -81,7 +81,7 @@ func TestAccAwsBackupRegionSettings_basic(t *testing.T) {
 func testAccCheckAwsBackupRegionSettingsExists(settings *backup.DescribeRegionSettingsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 		resp, err := conn.DescribeRegionSettings(&backup.DescribeRegionSettingsInput{})
 		if err != nil {
 			return err
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 )
 
 func resourceAwsBackupSelection() *schema.Resource {
@@ -42,7 +43,7 @@ func resourceAwsBackupSelection() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 			},
 			"selection_tag": {
 				Type:     schema.TypeSet,
@@ -82,7 +83,7 @@ func resourceAwsBackupSelection() *schema.Resource {
 }
 
 func resourceAwsBackupSelectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	selection := &backup.Selection{
 		IamRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
@@ -137,7 +138,7 @@ func resourceAwsBackupSelectionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.GetBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
@@ -187,7 +188,7 @@ func resourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsBackupSelectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.DeleteBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
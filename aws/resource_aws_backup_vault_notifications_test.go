@@ -25,7 +25,7 @@ func testSweepBackupVaultNotifications(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).backupconn
+	conn := client.(*AWSClient).backupconn()
 	var sweeperErrs *multierror.Error
 
 	input := &backup.ListBackupVaultsInput{}
@@ -119,7 +119,7 @@ func TestAccAwsBackupVaultNotification_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsBackupVaultNotificationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).backupconn
+	conn := testAccProvider.Meta().(*AWSClient).backupconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault_notifications" {
 			continue
@@ -148,7 +148,7 @@ func testAccCheckAwsBackupVaultNotificationExists(name string, vault *backup.Get
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 		params := &backup.GetBackupVaultNotificationsInput{
 			BackupVaultName: aws.String(rs.Primary.ID),
 		}
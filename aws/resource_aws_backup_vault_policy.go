@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAwsBackupVaultPolicy() *schema.Resource {
@@ -29,7 +28,7 @@ func resourceAwsBackupVaultPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validation.StringIsJSON,
+				ValidateFunc:     validateResourcePolicyDocument(iamPolicyTypeResource),
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"backup_vault_arn": {
@@ -41,7 +40,7 @@ func resourceAwsBackupVaultPolicy() *schema.Resource {
 }
 
 func resourceAwsBackupVaultPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.PutBackupVaultAccessPolicyInput{
 		BackupVaultName: aws.String(d.Get("backup_vault_name").(string)),
@@ -59,7 +58,7 @@ func resourceAwsBackupVaultPolicyPut(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsBackupVaultPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.GetBackupVaultAccessPolicyInput{
 		BackupVaultName: aws.String(d.Id()),
@@ -83,7 +82,7 @@ func resourceAwsBackupVaultPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsBackupVaultPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.DeleteBackupVaultAccessPolicyInput{
 		BackupVaultName: aws.String(d.Id()),
@@ -26,7 +26,7 @@ func testSweepBackupVaultPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).backupconn
+	conn := client.(*AWSClient).backupconn()
 	var sweeperErrs *multierror.Error
 
 	input := &backup.ListBackupVaultsInput{}
@@ -127,7 +127,7 @@ func TestAccAwsBackupVaultPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsBackupVaultPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).backupconn
+	conn := testAccProvider.Meta().(*AWSClient).backupconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault_policy" {
 			continue
@@ -156,7 +156,7 @@ func testAccCheckAwsBackupVaultPolicyExists(name string, vault *backup.GetBackup
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 		params := &backup.GetBackupVaultAccessPolicyInput{
 			BackupVaultName: aws.String(rs.Primary.ID),
 		}
@@ -34,8 +34,8 @@ func testSweepBatchComputeEnvironments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).batchconn
-	iamconn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).batchconn()
+	iamconn := client.(*AWSClient).iamconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -662,7 +662,7 @@ func TestAccAWSBatchComputeEnvironment_Tags(t *testing.T) {
 }
 
 func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_batch_compute_environment" {
@@ -689,7 +689,7 @@ func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
 
 func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_batch_compute_environment" {
@@ -717,7 +717,7 @@ func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 }
 
 func testAccPreCheckAWSBatch(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	input := &batch.DescribeComputeEnvironmentsInput{}
 
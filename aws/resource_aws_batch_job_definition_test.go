@@ -29,7 +29,7 @@ func testSweepBatchJobDefinitions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).batchconn
+	conn := client.(*AWSClient).batchconn()
 	input := &batch.DescribeJobDefinitionsInput{
 		Status: aws.String("ACTIVE"),
 	}
@@ -243,7 +243,7 @@ func testAccCheckBatchJobDefinitionExists(n string, jd *batch.JobDefinition) res
 			return fmt.Errorf("No Batch Job Queue ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		arn := rs.Primary.Attributes["arn"]
 		def, err := getJobDefinition(conn, arn)
 		if err != nil {
@@ -298,7 +298,7 @@ func testAccCheckBatchJobDefinitionDestroy(s *terraform.State) error {
 		if rs.Type != "aws_batch_job_definition" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		js, err := getJobDefinition(conn, rs.Primary.Attributes["arn"])
 		if err == nil && js != nil {
 			if *js.Status == "ACTIVE" {
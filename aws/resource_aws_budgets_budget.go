@@ -206,7 +206,7 @@ func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) er
 		budget.BudgetName = aws.String(resource.UniqueId())
 	}
 
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 	var accountID string
 	if v, ok := d.GetOk("account_id"); ok {
 		accountID = v.(string)
@@ -237,7 +237,7 @@ func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsBudgetsBudgetNotificationsCreate(notifications []*budgets.Notification, subscribers [][]*budgets.Subscriber, budgetName string, accountID string, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	for i, notification := range notifications {
 		subscribers := subscribers[i]
@@ -303,7 +303,7 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 	describeBudgetOutput, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
 		BudgetName: aws.String(budgetName),
 		AccountId:  aws.String(accountID),
@@ -354,7 +354,7 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsBudgetsBudgetNotificationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
 
@@ -430,7 +430,7 @@ func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 	budget, err := expandBudgetsBudgetUnmarshal(d)
 	if err != nil {
 		return fmt.Errorf("could not create budget: %v", err)
@@ -453,7 +453,7 @@ func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) er
 	return resourceAwsBudgetsBudgetRead(d, meta)
 }
 func resourceAwsBudgetsBudgetNotificationsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
 
 	if err != nil {
@@ -498,7 +498,7 @@ func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 	_, err = conn.DeleteBudget(&budgets.DeleteBudgetInput{
 		BudgetName: aws.String(budgetName),
 		AccountId:  aws.String(accountID),
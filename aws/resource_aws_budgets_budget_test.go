@@ -30,7 +30,7 @@ func testSweepBudgetsBudgets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).budgetconn
+	conn := client.(*AWSClient).budgetconn()
 	accountID := client.(*AWSClient).accountid
 	input := &budgets.DescribeBudgetsInput{
 		AccountId: aws.String(accountID),
@@ -285,7 +285,7 @@ func testAccAWSBudgetsBudgetExists(resourceName string, config budgets.Budget) r
 			return fmt.Errorf("failed decoding ID: %v", err)
 		}
 
-		client := testAccProvider.Meta().(*AWSClient).budgetconn
+		client := testAccProvider.Meta().(*AWSClient).budgetconn()
 		b, err := client.DescribeBudget(&budgets.DescribeBudgetInput{
 			BudgetName: &budgetName,
 			AccountId:  &accountID,
@@ -373,7 +373,7 @@ func testAccAWSBudgetsBudgetCheckCostTypes(config budgets.Budget, costTypes budg
 
 func testAccAWSBudgetsBudgetDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
-	client := meta.(*AWSClient).budgetconn
+	client := meta.(*AWSClient).budgetconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_budgets_budget" {
 			continue
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/waiter"
 )
@@ -33,7 +34,7 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 			"administration_role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -80,7 +81,9 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"template_body": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -99,7 +102,7 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 }
 
 func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 	name := d.Get("name").(string)
 
 	input := &cloudformation.CreateStackSetInput{
@@ -146,8 +149,8 @@ func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).cfconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &cloudformation.DescribeStackSetInput{
 		StackSetName: aws.String(d.Id()),
@@ -199,7 +202,7 @@ func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.UpdateStackSetInput{
 		AdministrationRoleARN: aws.String(d.Get("administration_role_arn").(string)),
@@ -248,7 +251,7 @@ func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.DeleteStackSetInput{
 		StackSetName: aws.String(d.Id()),
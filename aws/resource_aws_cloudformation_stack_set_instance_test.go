@@ -28,7 +28,7 @@ func testSweepCloudformationStackSetInstances(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).cfconn
+	conn := client.(*AWSClient).cfconn()
 	stackSets, err := listCloudFormationStackSets(conn)
 
 	if testSweepSkipSweepError(err) || isAWSErr(err, "ValidationError", "AWS CloudFormation StackSets is not supported") {
@@ -304,7 +304,7 @@ func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stack
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(rs.Primary.ID)
 
@@ -336,7 +336,7 @@ func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stack
 
 func testAccCheckCloudFormationStackSetInstanceStackExists(stackInstance *cloudformation.StackInstance, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DescribeStacksInput{
 			StackName: stackInstance.StackId,
@@ -359,7 +359,7 @@ func testAccCheckCloudFormationStackSetInstanceStackExists(stackInstance *cloudf
 }
 
 func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set_instance" {
@@ -27,7 +27,7 @@ func testSweepCloudFrontDistributions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudfrontconn
+	conn := client.(*AWSClient).cloudfrontconn()
 
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
 
@@ -862,7 +862,7 @@ func TestAccAWSCloudFrontDistribution_WaitForDeployment(t *testing.T) {
 }
 
 func testAccCheckCloudFrontDistributionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_distribution" {
@@ -907,7 +907,7 @@ func testAccCheckCloudFrontDistributionExists(resourceName string, distribution
 			return fmt.Errorf("Resource ID not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		input := &cloudfront.GetDistributionInput{
 			Id: aws.String(rs.Primary.ID),
@@ -927,7 +927,7 @@ func testAccCheckCloudFrontDistributionExists(resourceName string, distribution
 
 func testAccCheckCloudFrontDistributionExistsAPIOnly(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		input := &cloudfront.GetDistributionInput{
 			Id: distribution.Id,
@@ -991,7 +991,7 @@ func testAccCheckCloudFrontDistributionDisabled(distribution *cloudfront.Distrib
 // This requires the CloudFront Distribution to previously be disabled and fetches latest ETag automatically.
 func testAccCheckCloudFrontDistributionDisappears(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		getDistributionInput := &cloudfront.GetDistributionInput{
 			Id: distribution.Id,
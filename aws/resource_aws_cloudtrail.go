@@ -132,7 +132,9 @@ func resourceAwsCloudTrail() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"insight_selector": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -151,7 +153,7 @@ func resourceAwsCloudTrail() *schema.Resource {
 }
 
 func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).cloudtrailconn()
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().CloudtrailTags()
 
 	input := cloudtrail.CreateTrailInput{
@@ -242,8 +244,8 @@ func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).cloudtrailconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := cloudtrail.DescribeTrailsInput{
 		TrailNameList: []*string{
@@ -338,7 +340,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).cloudtrailconn()
 
 	input := cloudtrail.UpdateTrailInput{
 		Name: aws.String(d.Id()),
@@ -398,8 +400,8 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error updating CloudTrail: %s", err)
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.CloudtrailUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating ECR Repository (%s) tags: %s", d.Get("arn").(string), err)
@@ -434,7 +436,7 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCloudTrailDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).cloudtrailconn()
 
 	log.Printf("[DEBUG] Deleting CloudTrail: %q", d.Id())
 	_, err := conn.DeleteTrail(&cloudtrail.DeleteTrailInput{
@@ -82,13 +82,15 @@ func resourceAwsCloudWatchCompositeAlarm() *schema.Resource {
 					ValidateFunc: validateArn,
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsCloudWatchCompositeAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 	name := d.Get("alarm_name").(string)
 
 	input := expandAwsCloudWatchPutCompositeAlarmInput(d)
@@ -104,8 +106,8 @@ func resourceAwsCloudWatchCompositeAlarmCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceAwsCloudWatchCompositeAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudwatchconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).cloudwatchconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 	name := d.Id()
 
 	alarm, err := finder.CompositeAlarmByName(ctx, conn, name)
@@ -161,7 +163,7 @@ func resourceAwsCloudWatchCompositeAlarmRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAwsCloudWatchCompositeAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 	name := d.Id()
 
 	input := expandAwsCloudWatchPutCompositeAlarmInput(d)
@@ -172,8 +174,8 @@ func resourceAwsCloudWatchCompositeAlarmUpdate(ctx context.Context, d *schema.Re
 	}
 
 	arn := d.Get("arn").(string)
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.CloudwatchUpdateTags(conn, arn, o, n); err != nil {
 			return diag.Errorf("error updating tags: %s", err)
@@ -184,7 +186,7 @@ func resourceAwsCloudWatchCompositeAlarmUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceAwsCloudWatchCompositeAlarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 	name := d.Id()
 
 	input := cloudwatch.DeleteAlarmsInput{
@@ -31,13 +31,15 @@ func resourceAwsCloudWatchEventBus() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsCloudWatchEventBusCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	eventBusName := d.Get("name").(string)
 	input := &events.CreateEventBusInput{
@@ -63,8 +65,8 @@ func resourceAwsCloudWatchEventBusCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudWatchEventBusRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &events.DescribeEventBusInput{
 		Name: aws.String(d.Id()),
@@ -98,11 +100,11 @@ func resourceAwsCloudWatchEventBusRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCloudWatchEventBusUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	arn := d.Get("arn").(string)
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.CloudwatcheventsUpdateTags(conn, arn, o, n); err != nil {
 			return fmt.Errorf("error updating CloudwWatch Events event bus (%s) tags: %w", arn, err)
@@ -113,7 +115,7 @@ func resourceAwsCloudWatchEventBusUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudWatchEventBusDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 	log.Printf("[INFO] Deleting CloudWatch Events event bus (%s)", d.Id())
 	_, err := conn.DeleteEventBus(&events.DeleteEventBusInput{
 		Name: aws.String(d.Id()),
@@ -29,7 +29,7 @@ func testSweepCloudWatchEventBuses(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
+	conn := client.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.ListEventBusesInput{}
 
@@ -214,7 +214,7 @@ func TestAccAWSCloudWatchEventBus_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSCloudWatchEventBusDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_bus" {
@@ -242,7 +242,7 @@ func testAccCheckCloudWatchEventBusExists(n string, v *events.DescribeEventBusOu
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		params := events.DescribeEventBusInput{
 			Name: aws.String(rs.Primary.ID),
 		}
@@ -50,7 +50,7 @@ func resourceAwsCloudWatchLogDestination() *schema.Resource {
 }
 
 func resourceAwsCloudWatchLogDestinationPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	name := d.Get("name").(string)
 	role_arn := d.Get("role_arn").(string)
@@ -87,7 +87,7 @@ func resourceAwsCloudWatchLogDestinationPut(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCloudWatchLogDestinationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 	name := d.Get("name").(string)
 	destination, exists, err := lookupCloudWatchLogDestination(conn, name, nil)
 	if err != nil {
@@ -108,7 +108,7 @@ func resourceAwsCloudWatchLogDestinationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudWatchLogDestinationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	name := d.Get("name").(string)
 
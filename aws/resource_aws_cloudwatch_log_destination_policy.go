@@ -31,15 +31,16 @@ func resourceAwsCloudWatchLogDestinationPolicy() *schema.Resource {
 			},
 
 			"access_policy": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 		},
 	}
 }
 
 func resourceAwsCloudWatchLogDestinationPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	destination_name := d.Get("destination_name").(string)
 	access_policy := d.Get("access_policy").(string)
@@ -60,7 +61,7 @@ func resourceAwsCloudWatchLogDestinationPolicyPut(d *schema.ResourceData, meta i
 }
 
 func resourceAwsCloudWatchLogDestinationPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 	destination_name := d.Get("destination_name").(string)
 	destination, exists, err := lookupCloudWatchLogDestination(conn, destination_name, nil)
 	if err != nil {
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAwsCodeArtifactDomainPermissionsPolicy() *schema.Resource {
@@ -35,8 +34,8 @@ func resourceAwsCodeArtifactDomainPermissionsPolicy() *schema.Resource {
 			"policy_document": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ValidateFunc:     validateResourcePolicyDocument(iamPolicyTypeCodeArtifact),
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"policy_revision": {
 				Type:     schema.TypeString,
@@ -52,7 +51,7 @@ func resourceAwsCodeArtifactDomainPermissionsPolicy() *schema.Resource {
 }
 
 func resourceAwsCodeArtifactDomainPermissionsPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codeartifactconn
+	conn := meta.(*AWSClient).codeartifactconn()
 	log.Print("[DEBUG] Creating CodeArtifact Domain Permissions Policy")
 
 	params := &codeartifact.PutDomainPermissionsPolicyInput{
@@ -79,7 +78,7 @@ func resourceAwsCodeArtifactDomainPermissionsPolicyPut(d *schema.ResourceData, m
 }
 
 func resourceAwsCodeArtifactDomainPermissionsPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codeartifactconn
+	conn := meta.(*AWSClient).codeartifactconn()
 	log.Printf("[DEBUG] Reading CodeArtifact Domain Permissions Policy: %s", d.Id())
 
 	domainOwner, domainName, err := decodeCodeArtifactDomainID(d.Id())
@@ -110,7 +109,7 @@ func resourceAwsCodeArtifactDomainPermissionsPolicyRead(d *schema.ResourceData,
 }
 
 func resourceAwsCodeArtifactDomainPermissionsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codeartifactconn
+	conn := meta.(*AWSClient).codeartifactconn()
 	log.Printf("[DEBUG] Deleting CodeArtifact Domain Permissions Policy: %s", d.Id())
 
 	domainOwner, domainName, err := decodeCodeArtifactDomainID(d.Id())
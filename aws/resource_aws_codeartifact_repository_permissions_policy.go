@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAwsCodeArtifactRepositoryPermissionsPolicy() *schema.Resource {
@@ -40,8 +39,8 @@ func resourceAwsCodeArtifactRepositoryPermissionsPolicy() *schema.Resource {
 			"policy_document": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ValidateFunc:     validateResourcePolicyDocument(iamPolicyTypeCodeArtifact),
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"policy_revision": {
 				Type:     schema.TypeString,
@@ -57,7 +56,7 @@ func resourceAwsCodeArtifactRepositoryPermissionsPolicy() *schema.Resource {
 }
 
 func resourceAwsCodeArtifactRepositoryPermissionsPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codeartifactconn
+	conn := meta.(*AWSClient).codeartifactconn()
 	log.Print("[DEBUG] Creating CodeArtifact Repository Permissions Policy")
 
 	params := &codeartifact.PutRepositoryPermissionsPolicyInput{
@@ -85,7 +84,7 @@ func resourceAwsCodeArtifactRepositoryPermissionsPolicyPut(d *schema.ResourceDat
 }
 
 func resourceAwsCodeArtifactRepositoryPermissionsPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codeartifactconn
+	conn := meta.(*AWSClient).codeartifactconn()
 	log.Printf("[DEBUG] Reading CodeArtifact Repository Permissions Policy: %s", d.Id())
 
 	domainOwner, domainName, repoName, err := decodeCodeArtifactRepositoryID(d.Id())
@@ -118,7 +117,7 @@ func resourceAwsCodeArtifactRepositoryPermissionsPolicyRead(d *schema.ResourceDa
 }
 
 func resourceAwsCodeArtifactRepositoryPermissionsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codeartifactconn
+	conn := meta.(*AWSClient).codeartifactconn()
 	log.Printf("[DEBUG] Deleting CodeArtifact Repository Permissions Policy: %s", d.Id())
 
 	domainOwner, domainName, repoName, err := decodeCodeArtifactRepositoryID(d.Id())
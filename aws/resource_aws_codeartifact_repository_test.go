@@ -25,7 +25,7 @@ func testSweepCodeArtifactRepositories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).codeartifactconn
+	conn := client.(*AWSClient).codeartifactconn()
 	input := &codeartifact.ListRepositoriesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -327,7 +327,7 @@ func testAccCheckAWSCodeArtifactRepositoryExists(n string) resource.TestCheckFun
 			return fmt.Errorf("no CodeArtifact repository set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn
+		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn()
 		owner, domain, repo, err := decodeCodeArtifactRepositoryID(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -352,7 +352,7 @@ func testAccCheckAWSCodeArtifactRepositoryDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn
+		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn()
 		resp, err := conn.DescribeRepository(&codeartifact.DescribeRepositoryInput{
 			Repository:  aws.String(repo),
 			Domain:      aws.String(domain),
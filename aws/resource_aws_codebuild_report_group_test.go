@@ -156,7 +156,7 @@ func TestAccAWSCodeBuildReportGroup_disappears(t *testing.T) {
 }
 
 func testAccPreCheckAWSCodeBuildReportGroup(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	input := &codebuild.ListReportGroupsInput{}
 
@@ -172,7 +172,7 @@ func testAccPreCheckAWSCodeBuildReportGroup(t *testing.T) {
 }
 
 func testAccCheckAWSCodeBuildReportGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_report_group" {
@@ -206,7 +206,7 @@ func testAccCheckAWSCodeBuildReportGroupExists(name string, reportGroup *codebui
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 		resp, err := conn.BatchGetReportGroups(&codebuild.BatchGetReportGroupsInput{
 			ReportGroupArns: aws.StringSlice([]string{rs.Primary.ID}),
@@ -219,7 +219,7 @@ func testAccCheckAWSCodeBuildWebhookFilter(webhook *codebuild.Webhook, expectedF
 }
 
 func testAccCheckAWSCodeBuildWebhookDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_webhook" {
@@ -255,7 +255,7 @@ func testAccCheckAWSCodeBuildWebhookExists(name string, webhook *codebuild.Webho
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 		resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
 			Names: []*string{
@@ -236,7 +236,7 @@ func testAccCheckAWSCodePipelineWebhookExists(n string, webhook *codepipeline.Li
 			return fmt.Errorf("No webhook ARN is set as ID")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codepipelineconn
+		conn := testAccProvider.Meta().(*AWSClient).codepipelineconn()
 
 		resp, err := getCodePipelineWebhook(conn, rs.Primary.ID)
 
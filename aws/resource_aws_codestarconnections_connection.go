@@ -48,7 +48,7 @@ func resourceAwsCodeStarConnectionsConnection() *schema.Resource {
 }
 
 func resourceAwsCodeStarConnectionsConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codestarconnectionsconn
+	conn := meta.(*AWSClient).codestarconnectionsconn()
 
 	params := &codestarconnections.CreateConnectionInput{
 		ConnectionName: aws.String(d.Get("name").(string)),
@@ -66,7 +66,7 @@ func resourceAwsCodeStarConnectionsConnectionCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsCodeStarConnectionsConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codestarconnectionsconn
+	conn := meta.(*AWSClient).codestarconnectionsconn()
 
 	resp, err := conn.GetConnection(&codestarconnections.GetConnectionInput{
 		ConnectionArn: aws.String(d.Id()),
@@ -94,7 +94,7 @@ func resourceAwsCodeStarConnectionsConnectionRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsCodeStarConnectionsConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codestarconnectionsconn
+	conn := meta.(*AWSClient).codestarconnectionsconn()
 
 	_, err := conn.DeleteConnection(&codestarconnections.DeleteConnectionInput{
 		ConnectionArn: aws.String(d.Id()),
@@ -309,7 +309,7 @@ func testAccAWSCognitoUserPoolClientImportStateIDFunc(resourceName string) resou
 			return "", errors.New("No Cognito User Pool Client ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 		userPoolId := rs.Primary.Attributes["user_pool_id"]
 		clientId := rs.Primary.ID
 
@@ -329,7 +329,7 @@ func testAccAWSCognitoUserPoolClientImportStateIDFunc(resourceName string) resou
 }
 
 func testAccCheckAWSCognitoUserPoolClientDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_user_pool_client" {
@@ -365,7 +365,7 @@ func testAccCheckAWSCognitoUserPoolClientExists(name string, client *cognitoiden
 			return errors.New("No Cognito User Pool Client ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		params := &cognitoidentityprovider.DescribeUserPoolClientInput{
 			ClientId:   aws.String(rs.Primary.ID),
@@ -385,7 +385,7 @@ func testAccCheckAWSCognitoUserPoolClientExists(name string, client *cognitoiden
 
 func testAccCheckAWSCognitoUserPoolClientDisappears(client *cognitoidentityprovider.UserPoolClientType) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		params := &cognitoidentityprovider.DeleteUserPoolClientInput{
 			ClientId:   client.ClientId,
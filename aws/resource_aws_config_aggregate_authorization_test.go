@@ -25,7 +25,7 @@ func testSweepConfigAggregateAuthorizations(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).configconn
+	conn := client.(*AWSClient).configconn()
 
 	aggregateAuthorizations, err := describeConfigAggregateAuthorizations(conn)
 	if err != nil {
@@ -127,7 +127,7 @@ func TestAccAWSConfigAggregateAuthorization_tags(t *testing.T) {
 }
 
 func testAccCheckAWSConfigAggregateAuthorizationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_aggregate_authorization" {
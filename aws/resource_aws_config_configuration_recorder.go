@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 )
 
 func resourceAwsConfigConfigurationRecorder() *schema.Resource {
@@ -32,7 +33,7 @@ func resourceAwsConfigConfigurationRecorder() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 			},
 			"recording_group": {
 				Type:     schema.TypeList,
@@ -64,7 +65,7 @@ func resourceAwsConfigConfigurationRecorder() *schema.Resource {
 }
 
 func resourceAwsConfigConfigurationRecorderPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("name").(string)
 	recorder := configservice.ConfigurationRecorder{
@@ -90,7 +91,7 @@ func resourceAwsConfigConfigurationRecorderPut(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsConfigConfigurationRecorderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := configservice.DescribeConfigurationRecordersInput{
 		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
@@ -134,7 +135,7 @@ func resourceAwsConfigConfigurationRecorderRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsConfigConfigurationRecorderDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 	input := configservice.DeleteConfigurationRecorderInput{
 		ConfigurationRecorderName: aws.String(d.Id()),
 	}
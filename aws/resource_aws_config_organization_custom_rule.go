@@ -121,7 +121,7 @@ func resourceAwsConfigOrganizationCustomRule() *schema.Resource {
 }
 
 func resourceAwsConfigOrganizationCustomRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 	name := d.Get("name").(string)
 
 	input := &configservice.PutOrganizationConfigRuleInput{
@@ -180,7 +180,7 @@ func resourceAwsConfigOrganizationCustomRuleCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsConfigOrganizationCustomRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	rule, err := configDescribeOrganizationConfigRule(conn, d.Id())
 
@@ -236,7 +236,7 @@ func resourceAwsConfigOrganizationCustomRuleRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsConfigOrganizationCustomRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := &configservice.PutOrganizationConfigRuleInput{
 		OrganizationConfigRuleName: aws.String(d.Id()),
@@ -292,7 +292,7 @@ func resourceAwsConfigOrganizationCustomRuleUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsConfigOrganizationCustomRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := &configservice.DeleteOrganizationConfigRuleInput{
 		OrganizationConfigRuleName: aws.String(d.Id()),
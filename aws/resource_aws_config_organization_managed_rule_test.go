@@ -392,7 +392,7 @@ func testAccCheckConfigOrganizationManagedRuleExists(resourceName string, ocr *c
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 		rule, err := configDescribeOrganizationConfigRule(conn, rs.Primary.ID)
 
@@ -411,7 +411,7 @@ func testAccCheckConfigOrganizationManagedRuleExists(resourceName string, ocr *c
 }
 
 func testAccCheckConfigOrganizationManagedRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_organization_managed_rule" {
@@ -52,7 +52,9 @@ func resourceAwsCustomerGateway() *schema.Resource {
 				}, false),
 			},
 
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -62,7 +64,7 @@ func resourceAwsCustomerGateway() *schema.Resource {
 }
 
 func resourceAwsCustomerGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	ipAddress := d.Get("ip_address").(string)
 	vpnType := d.Get("type").(string)
@@ -181,8 +183,8 @@ func resourceAwsCustomerGatewayExists(vpnType, ipAddress, bgpAsn string, conn *e
 }
 
 func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).ec2conn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	gatewayFilter := &ec2.Filter{
 		Name:   aws.String("customer-gateway-id"),
@@ -236,10 +238,10 @@ func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 Customer Gateway (%s) tags: %s", d.Id(), err)
@@ -250,7 +252,7 @@ func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCustomerGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	_, err := conn.DeleteCustomerGateway(&ec2.DeleteCustomerGatewayInput{
 		CustomerGatewayId: aws.String(d.Id()),
@@ -35,13 +35,15 @@ func resourceAwsDataPipelinePipeline() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsDataPipelinePipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datapipelineconn
+	conn := meta.(*AWSClient).datapipelineconn()
 
 	uniqueID := resource.UniqueId()
 
@@ -67,8 +69,8 @@ func resourceAwsDataPipelinePipelineCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datapipelineconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).datapipelineconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	v, err := resourceAwsDataPipelinePipelineRetrieve(d.Id(), conn)
 	if isAWSErr(err, datapipeline.ErrCodePipelineNotFoundException, "") || isAWSErr(err, datapipeline.ErrCodePipelineDeletedException, "") || v == nil {
@@ -90,10 +92,10 @@ func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDataPipelinePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datapipelineconn
+	conn := meta.(*AWSClient).datapipelineconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.DatapipelineUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating Datapipeline Pipeline (%s) tags: %s", d.Id(), err)
@@ -104,7 +106,7 @@ func resourceAwsDataPipelinePipelineUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDataPipelinePipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datapipelineconn
+	conn := meta.(*AWSClient).datapipelineconn()
 
 	opts := datapipeline.DeletePipelineInput{
 		PipelineId: aws.String(d.Id()),
@@ -152,7 +152,7 @@ func TestAccAWSDataPipelinePipeline_tags(t *testing.T) {
 
 func testAccCheckAWSDataPipelinePipelineDisappears(conf *datapipeline.PipelineDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datapipelineconn
+		conn := testAccProvider.Meta().(*AWSClient).datapipelineconn()
 		params := &datapipeline.DeletePipelineInput{
 			PipelineId: conf.PipelineId,
 		}
@@ -166,7 +166,7 @@ func testAccCheckAWSDataPipelinePipelineDisappears(conf *datapipeline.PipelineDe
 }
 
 func testAccCheckAWSDataPipelinePipelineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datapipelineconn
+	conn := testAccProvider.Meta().(*AWSClient).datapipelineconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datapipeline_pipeline" {
@@ -202,7 +202,7 @@ func testAccCheckAWSDataPipelinePipelineExists(n string, v *datapipeline.Pipelin
 			return fmt.Errorf("No DataPipeline ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datapipelineconn
+		conn := testAccProvider.Meta().(*AWSClient).datapipelineconn()
 
 		pipelineDescription, err := resourceAwsDataPipelinePipelineRetrieve(rs.Primary.ID, conn)
 
@@ -219,7 +219,7 @@ func testAccCheckAWSDataPipelinePipelineExists(n string, v *datapipeline.Pipelin
 }
 
 func testAccPreCheckAWSDataPipeline(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).datapipelineconn
+	conn := testAccProvider.Meta().(*AWSClient).datapipelineconn()
 
 	input := &datapipeline.ListPipelinesInput{}
 
@@ -71,7 +71,9 @@ func resourceAwsDataSyncLocationEfs() *schema.Resource {
 					return false
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"uri": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -81,7 +83,7 @@ func resourceAwsDataSyncLocationEfs() *schema.Resource {
 }
 
 func resourceAwsDataSyncLocationEfsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.CreateLocationEfsInput{
 		Ec2Config:        expandDataSyncEc2Config(d.Get("ec2_config").([]interface{})),
@@ -102,8 +104,8 @@ func resourceAwsDataSyncLocationEfsCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationEfsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).datasyncconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &datasync.DescribeLocationEfsInput{
 		LocationArn: aws.String(d.Id()),
@@ -151,10 +153,10 @@ func resourceAwsDataSyncLocationEfsRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDataSyncLocationEfsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.DatasyncUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating DataSync Location EFS (%s) tags: %s", d.Id(), err)
@@ -165,7 +167,7 @@ func resourceAwsDataSyncLocationEfsUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationEfsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DeleteLocationInput{
 		LocationArn: aws.String(d.Id()),
@@ -64,7 +64,9 @@ func resourceAwsDataSyncLocationNfs() *schema.Resource {
 					return false
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"uri": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -74,7 +76,7 @@ func resourceAwsDataSyncLocationNfs() *schema.Resource {
 }
 
 func resourceAwsDataSyncLocationNfsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.CreateLocationNfsInput{
 		OnPremConfig:   expandDataSyncOnPremConfig(d.Get("on_prem_config").([]interface{})),
@@ -95,8 +97,8 @@ func resourceAwsDataSyncLocationNfsCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationNfsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).datasyncconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &datasync.DescribeLocationNfsInput{
 		LocationArn: aws.String(d.Id()),
@@ -144,10 +146,10 @@ func resourceAwsDataSyncLocationNfsRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDataSyncLocationNfsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.DatasyncUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating DataSync Location NFS (%s) tags: %s", d.Id(), err)
@@ -158,7 +160,7 @@ func resourceAwsDataSyncLocationNfsUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationNfsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DeleteLocationInput{
 		LocationArn: aws.String(d.Id()),
@@ -87,7 +87,9 @@ func resourceAwsDataSyncLocationSmb() *schema.Resource {
 				},
 				*/
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"uri": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -102,7 +104,7 @@ func resourceAwsDataSyncLocationSmb() *schema.Resource {
 }
 
 func resourceAwsDataSyncLocationSmbCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.CreateLocationSmbInput{
 		AgentArns:      expandStringSet(d.Get("agent_arns").(*schema.Set)),
@@ -130,8 +132,8 @@ func resourceAwsDataSyncLocationSmbCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationSmbRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).datasyncconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &datasync.DescribeLocationSmbInput{
 		LocationArn: aws.String(d.Id()),
@@ -191,10 +193,10 @@ func resourceAwsDataSyncLocationSmbRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDataSyncLocationSmbUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.DatasyncUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating Datasync SMB location (%s) tags: %s", d.Id(), err)
@@ -204,7 +206,7 @@ func resourceAwsDataSyncLocationSmbUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationSmbDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DeleteLocationInput{
 		LocationArn: aws.String(d.Id()),
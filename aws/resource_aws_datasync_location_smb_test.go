@@ -27,7 +27,7 @@ func testSweepDataSyncLocationSmbs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
+	conn := client.(*AWSClient).datasyncconn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -184,7 +184,7 @@ func TestAccAWSDataSyncLocationSmb_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationSmbDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_smb" {
@@ -216,7 +216,7 @@ func testAccCheckAWSDataSyncLocationSmbExists(resourceName string, locationSmb *
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationSmbInput{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -239,7 +239,7 @@ func testAccCheckAWSDataSyncLocationSmbExists(resourceName string, locationSmb *
 
 func testAccCheckAWSDataSyncLocationSmbDisappears(location *datasync.DescribeLocationSmbOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
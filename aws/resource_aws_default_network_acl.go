@@ -183,7 +183,10 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 				Set: resourceAwsNetworkAclEntryHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"ignore_tags": ignoreTagsSchema(),
 
 			"owner_id": {
 				Type:     schema.TypeString,
@@ -208,7 +211,7 @@ func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	if d.HasChange("ingress") {
 		err := updateNetworkAclEntries(d, "ingress", conn)
@@ -272,8 +275,8 @@ func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 Default Network ACL (%s) tags: %s", d.Id(), err)
@@ -292,7 +295,7 @@ func resourceAwsDefaultNetworkAclDelete(d *schema.ResourceData, meta interface{}
 // revokeAllNetworkACLEntries revoke all ingress and egress rules that the Default
 // Network ACL currently has
 func revokeAllNetworkACLEntries(netaclId string, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
 		NetworkAclIds: []*string{aws.String(netaclId)},
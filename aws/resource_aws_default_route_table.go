@@ -112,7 +112,10 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 				Set: resourceAwsRouteTableHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"ignore_tags": ignoreTagsSchema(),
 
 			"owner_id": {
 				Type:     schema.TypeString,
@@ -125,7 +128,7 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("default_route_table_id").(string))
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, d.Id())()
 	if err != nil {
 		return fmt.Errorf("error reading EC2 Default Route Table (%s): %s", d.Id(), err)
@@ -155,7 +158,7 @@ func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDefaultRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	// look up default route table for VPC
 	filter1 := &ec2.Filter{
 		Name:   aws.String("association.main"),
@@ -199,7 +202,7 @@ func resourceAwsDefaultRouteTableDelete(d *schema.ResourceData, meta interface{}
 // revokeAllRouteTableRules revoke all routes on the Default Route Table
 // This should only be ran once at creation time of this resource
 func revokeAllRouteTableRules(defaultRouteTableId string, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	log.Printf("\n***\nrevokeAllRouteTableRules\n***\n")
 
 	resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
@@ -34,7 +34,7 @@ func testSweepDirectoryServiceDirectories(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).dsconn
+	conn := client.(*AWSClient).dsconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -303,7 +303,7 @@ func TestAccAWSDirectoryServiceDirectory_withAliasAndSso(t *testing.T) {
 }
 
 func testAccCheckDirectoryServiceDirectoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dsconn
+	conn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_directory_service_directory" {
@@ -367,7 +367,7 @@ func testAccCheckServiceDirectoryExists(name string, ds *directoryservice.Direct
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dsconn
+		conn := testAccProvider.Meta().(*AWSClient).dsconn()
 		out, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
 			DirectoryIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -402,7 +402,7 @@ func testAccCheckServiceDirectoryAlias(name, alias string) resource.TestCheckFun
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dsconn
+		conn := testAccProvider.Meta().(*AWSClient).dsconn()
 		out, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
 			DirectoryIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -431,7 +431,7 @@ func testAccCheckServiceDirectorySso(name string, ssoEnabled bool) resource.Test
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dsconn
+		conn := testAccProvider.Meta().(*AWSClient).dsconn()
 		out, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
 			DirectoryIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -450,7 +450,7 @@ func testAccCheckServiceDirectorySso(name string, ssoEnabled bool) resource.Test
 }
 
 func testAccPreCheckAWSDirectoryService(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).dsconn
+	conn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 	input := &directoryservice.DescribeDirectoriesInput{}
 
@@ -469,7 +469,7 @@ func testAccPreCheckAWSDirectoryService(t *testing.T) {
 // and we do not have a good read-only way to determine this situation. Here we
 // opt to perform a creation that will fail so we can determine Simple AD support.
 func testAccPreCheckAWSDirectoryServiceSimpleDirectory(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).dsconn
+	conn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 	input := &directoryservice.CreateDirectoryInput{
 		Name:     aws.String("corp.example.com"),
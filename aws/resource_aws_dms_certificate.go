@@ -48,7 +48,7 @@ func resourceAwsDmsCertificate() *schema.Resource {
 }
 
 func resourceAwsDmsCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	request := &dms.ImportCertificateInput{
 		CertificateIdentifier: aws.String(d.Get("certificate_id").(string)),
@@ -83,7 +83,7 @@ func resourceAwsDmsCertificateCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsDmsCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	response, err := conn.DescribeCertificates(&dms.DescribeCertificatesInput{
 		Filters: []*dms.Filter{
@@ -105,7 +105,7 @@ func resourceAwsDmsCertificateRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDmsCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	request := &dms.DeleteCertificateInput{
 		CertificateArn: aws.String(d.Get("certificate_arn").(string)),
@@ -73,7 +73,7 @@ func checkDmsCertificateExistsWithProviders(n string, providers *[]*schema.Provi
 				continue
 			}
 
-			conn := provider.Meta().(*AWSClient).dmsconn
+			conn := provider.Meta().(*AWSClient).dmsconn()
 			_, err := conn.DescribeCertificates(&dms.DescribeCertificatesInput{
 				Filters: []*dms.Filter{
 					{
@@ -75,7 +75,7 @@ func resourceAwsDxBgpPeer() *schema.Resource {
 }
 
 func resourceAwsDxBgpPeerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	vifId := d.Get("virtual_interface_id").(string)
 	addrFamily := d.Get("address_family").(string)
@@ -128,7 +128,7 @@ func resourceAwsDxBgpPeerCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDxBgpPeerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	vifId := d.Get("virtual_interface_id").(string)
 	addrFamily := d.Get("address_family").(string)
@@ -156,7 +156,7 @@ func resourceAwsDxBgpPeerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxBgpPeerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	vifId := d.Get("virtual_interface_id").(string)
 	addrFamily := d.Get("address_family").(string)
@@ -28,7 +28,7 @@ func testSweepDirectConnectGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 	input := &directconnect.DescribeDirectConnectGatewaysInput{}
 
 	for {
@@ -166,7 +166,7 @@ func TestAccAwsDxGateway_complex(t *testing.T) {
 }
 
 func testAccCheckAwsDxGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_gateway" {
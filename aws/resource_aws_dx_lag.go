@@ -52,7 +52,9 @@ func resourceAwsDxLag() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"has_logical_redundancy": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -62,7 +64,7 @@ func resourceAwsDxLag() *schema.Resource {
 }
 
 func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	req := &directconnect.CreateLagInput{
 		ConnectionsBandwidth: aws.String(d.Get("connections_bandwidth").(string)),
@@ -98,8 +100,8 @@ func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).dxconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	resp, err := conn.DescribeLags(&directconnect.DescribeLagsInput{
 		LagId: aws.String(d.Id()),
@@ -160,7 +162,7 @@ func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	if d.HasChange("name") {
 		req := &directconnect.UpdateLagInput{
@@ -176,8 +178,8 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	arn := d.Get("arn").(string)
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.DirectconnectUpdateTags(conn, arn, o, n); err != nil {
 			return fmt.Errorf("error updating Direct Connect LAG (%s) tags: %s", arn, err)
@@ -188,7 +190,7 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	if d.Get("force_destroy").(bool) {
 		resp, err := conn.DescribeLags(&directconnect.DescribeLagsInput{
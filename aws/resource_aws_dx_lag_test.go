@@ -97,7 +97,7 @@ func TestAccAWSDxLag_tags(t *testing.T) {
 }
 
 func testAccCheckAwsDxLagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_lag" {
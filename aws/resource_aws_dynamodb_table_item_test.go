@@ -227,7 +227,7 @@ func TestAccAWSDynamoDbTableItem_updateWithRangeKey(t *testing.T) {
 }
 
 func testAccCheckAWSDynamoDbItemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_table_item" {
@@ -274,7 +274,7 @@ func testAccCheckAWSDynamoDbTableItemExists(n string, item *dynamodb.GetItemOutp
 			return fmt.Errorf("No DynamoDB table item ID specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		attrs := rs.Primary.Attributes
 		attributes, err := expandDynamoDbTableItemAttributes(attrs["item"])
@@ -301,7 +301,7 @@ func testAccCheckAWSDynamoDbTableItemExists(n string, item *dynamodb.GetItemOutp
 
 func testAccCheckAWSDynamoDbTableItemCount(tableName string, count int64) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 		out, err := conn.Scan(&dynamodb.ScanInput{
 			ConsistentRead: aws.Bool(true),
 			TableName:      aws.String(tableName),
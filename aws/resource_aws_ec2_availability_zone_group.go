@@ -45,7 +45,7 @@ func resourceAwsEc2AvailabilityZoneGroup() *schema.Resource {
 }
 
 func resourceAwsEc2AvailabilityZoneGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	configurationOptInStatus := d.Get("opt_in_status").(string)
 
@@ -76,7 +76,7 @@ func resourceAwsEc2AvailabilityZoneGroupCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsEc2AvailabilityZoneGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	availabilityZone, err := ec2DescribeAvailabilityZoneGroup(conn, d.Id())
 
@@ -95,7 +95,7 @@ func resourceAwsEc2AvailabilityZoneGroupRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEc2AvailabilityZoneGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	optInStatus := d.Get("opt_in_status").(string)
 
 	input := &ec2.ModifyAvailabilityZoneGroupInput{
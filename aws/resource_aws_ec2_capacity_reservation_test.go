@@ -25,7 +25,7 @@ func testSweepEc2CapacityReservations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{})
 
@@ -419,7 +419,7 @@ func testAccCheckEc2CapacityReservationExists(resourceName string, cr *ec2.Capac
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{
 			CapacityReservationIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -444,7 +444,7 @@ func testAccCheckEc2CapacityReservationExists(resourceName string, cr *ec2.Capac
 }
 
 func testAccCheckEc2CapacityReservationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_capacity_reservation" {
@@ -471,7 +471,7 @@ func testAccCheckEc2CapacityReservationDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckAWSEc2CapacityReservation(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeCapacityReservationsInput{
 		MaxResults: aws.Int64(1),
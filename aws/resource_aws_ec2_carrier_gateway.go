@@ -36,7 +36,10 @@ func resourceAwsEc2CarrierGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"ignore_tags": ignoreTagsSchema(),
 
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -48,7 +51,7 @@ func resourceAwsEc2CarrierGateway() *schema.Resource {
 }
 
 func resourceAwsEc2CarrierGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.CreateCarrierGatewayInput{
 		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), "carrier-gateway"),
@@ -74,8 +77,8 @@ func resourceAwsEc2CarrierGatewayCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEc2CarrierGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).ec2conn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	carrierGateway, err := finder.CarrierGatewayByID(conn, d.Id())
 
@@ -114,10 +117,10 @@ func resourceAwsEc2CarrierGatewayRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEc2CarrierGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 Carrier Gateway (%s) tags: %w", d.Id(), err)
@@ -128,7 +131,7 @@ func resourceAwsEc2CarrierGatewayUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEc2CarrierGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[INFO] Deleting EC2 Carrier Gateway (%s)", d.Id())
 	_, err := conn.DeleteCarrierGateway(&ec2.DeleteCarrierGatewayInput{
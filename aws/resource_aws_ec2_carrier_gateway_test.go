@@ -29,7 +29,7 @@ func testSweepEc2CarrierGateway(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeCarrierGatewaysInput{}
 	var sweeperErrs *multierror.Error
 
@@ -166,7 +166,7 @@ func TestAccAWSEc2CarrierGateway_Tags(t *testing.T) {
 }
 
 func testAccCheckEc2CarrierGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_carrier_gateway" {
@@ -204,7 +204,7 @@ func testAccCheckEc2CarrierGatewayExists(n string, v *ec2.CarrierGateway) resour
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		out, err := finder.CarrierGatewayByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -223,7 +223,7 @@ func testAccCheckEc2CarrierGatewayExists(n string, v *ec2.CarrierGateway) resour
 }
 
 func testAccPreCheckAWSWavelengthZoneAvailable(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeAvailabilityZonesInput{
 		Filters: buildEC2AttributeFilterList(map[string]string{
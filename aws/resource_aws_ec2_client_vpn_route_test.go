@@ -101,7 +101,7 @@ func testAccAwsEc2ClientVpnRoute_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsEc2ClientVpnRouteDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_client_vpn_route" {
@@ -130,7 +130,7 @@ func testAccCheckAwsEc2ClientVpnRouteExists(name string, route *ec2.ClientVpnRou
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := finder.ClientVpnRouteByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -231,7 +231,9 @@ func resourceAwsEc2Fleet() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"target_capacity_specification": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -336,7 +338,7 @@ func resourceAwsEc2Fleet() *schema.Resource {
 }
 
 func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.CreateFleetInput{
 		ExcessCapacityTerminationPolicy:  aws.String(d.Get("excess_capacity_termination_policy").(string)),
@@ -395,8 +397,8 @@ func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).ec2conn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &ec2.DescribeFleetsInput{
 		FleetIds: []*string{aws.String(d.Id())},
@@ -486,7 +488,7 @@ func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2FleetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.ModifyFleetInput{
 		ExcessCapacityTerminationPolicy: aws.String(d.Get("excess_capacity_termination_policy").(string)),
@@ -518,8 +520,8 @@ func resourceAwsEc2FleetUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for EC2 Fleet (%s) modification: %s", d.Id(), err)
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
@@ -530,7 +532,7 @@ func resourceAwsEc2FleetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2FleetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DeleteFleetsInput{
 		FleetIds:           []*string{aws.String(d.Id())},
@@ -47,7 +47,7 @@ func resourceAwsEc2LocalGatewayRoute() *schema.Resource {
 }
 
 func resourceAwsEc2LocalGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	destination := d.Get("destination_cidr_block").(string)
 	localGatewayRouteTableID := d.Get("local_gateway_route_table_id").(string)
@@ -70,7 +70,7 @@ func resourceAwsEc2LocalGatewayRouteCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsEc2LocalGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	localGatewayRouteTableID, destination, err := decodeEc2LocalGatewayRouteID(d.Id())
 	if err != nil {
@@ -134,7 +134,7 @@ func resourceAwsEc2LocalGatewayRouteRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsEc2LocalGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	localGatewayRouteTableID, destination, err := decodeEc2LocalGatewayRouteID(d.Id())
 	if err != nil {
@@ -234,7 +234,7 @@ func TestAccAwsEc2ManagedPrefixList_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsEc2ManagedPrefixListDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_managed_prefix_list" {
@@ -271,7 +271,7 @@ func testAccAwsEc2ManagedPrefixListExists(resourceName string) resource.TestChec
 			return fmt.Errorf("resource %s has not set its id", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		pl, err := finder.ManagedPrefixListByID(conn, rs.Primary.ID)
 
@@ -49,7 +49,7 @@ func extractResourceIDAndKeyFromEc2TagID(id string) (string, string, error) {
 }
 
 func resourceAwsEc2TagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	resourceID := d.Get("resource_id").(string)
 	key := d.Get("key").(string)
@@ -65,7 +65,7 @@ func resourceAwsEc2TagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2TagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	resourceID, key, err := extractResourceIDAndKeyFromEc2TagID(d.Id())
 
 	if err != nil {
@@ -92,7 +92,7 @@ func resourceAwsEc2TagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2TagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	resourceID, key, err := extractResourceIDAndKeyFromEc2TagID(d.Id())
 
 	if err != nil {
@@ -107,7 +107,7 @@ func resourceAwsEc2TagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2TagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	resourceID, key, err := extractResourceIDAndKeyFromEc2TagID(d.Id())
 
 	if err != nil {
@@ -103,7 +103,7 @@ func resourceAwsEc2TrafficMirrorFilterRule() *schema.Resource {
 }
 
 func resourceAwsEc2TrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	filterId := d.Get("traffic_mirror_filter_id")
 
@@ -142,7 +142,7 @@ func resourceAwsEc2TrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsEc2TrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ruleId := d.Id()
 
 	var rule *ec2.TrafficMirrorFilterRule
@@ -214,7 +214,7 @@ func findEc2TrafficMirrorFilterRule(ruleId string, filters []*ec2.TrafficMirrorF
 }
 
 func resourceAwsEc2TrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ruleId := d.Id()
 
 	input := &ec2.ModifyTrafficMirrorFilterRuleInput{
@@ -297,7 +297,7 @@ func resourceAwsEc2TrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsEc2TrafficMirrorFilterRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	ruleId := d.Id()
 	input := &ec2.DeleteTrafficMirrorFilterRuleInput{
@@ -112,7 +112,7 @@ func testAccCheckAWSEc2TrafficMirrorFilterRuleExists(name string) resource.TestC
 		ruleId := rs.Primary.ID
 		filterId := rs.Primary.Attributes["traffic_mirror_filter_id"]
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		out, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{
 			TrafficMirrorFilterIds: []*string{
 				aws.String(filterId),
@@ -191,7 +191,7 @@ resource "aws_ec2_traffic_mirror_filter_rule" "rule" {
 }
 
 func testAccPreCheckAWSEc2TrafficMirrorFilterRule(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	_, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{})
 
@@ -205,7 +205,7 @@ func testAccPreCheckAWSEc2TrafficMirrorFilterRule(t *testing.T) {
 }
 
 func testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_traffic_mirror_filter_rule" {
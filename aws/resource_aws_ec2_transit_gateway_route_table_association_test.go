@@ -56,7 +56,7 @@ func testAccCheckAWSEc2TransitGatewayRouteTableAssociationExists(resourceName st
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		association, err := ec2DescribeTransitGatewayRouteTableAssociation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
 
@@ -75,7 +75,7 @@ func testAccCheckAWSEc2TransitGatewayRouteTableAssociationExists(resourceName st
 }
 
 func testAccCheckAWSEc2TransitGatewayRouteTableAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route_table_association" {
@@ -61,7 +61,7 @@ func TestAccAWSEcrRepositoryPolicy_iam(t *testing.T) {
 }
 
 func testAccCheckAWSEcrRepositoryPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_repository_policy" {
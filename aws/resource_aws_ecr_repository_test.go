@@ -26,7 +26,7 @@ func testSweepEcrRepositories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).ecrconn
+	conn := client.(*AWSClient).ecrconn()
 
 	var errors error
 	err = conn.DescribeRepositoriesPages(&ecr.DescribeRepositoriesInput{}, func(page *ecr.DescribeRepositoriesOutput, isLast bool) bool {
@@ -294,7 +294,7 @@ func TestAccAWSEcrRepository_encryption_aes256(t *testing.T) {
 }
 
 func testAccCheckAWSEcrRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_repository" {
@@ -336,7 +336,7 @@ func testAccCheckAWSEcrRepositoryExists(name string, res *ecr.Repository) resour
 			return fmt.Errorf("No ECR repository ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecrconn
+		conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 		output, err := conn.DescribeRepositories(&ecr.DescribeRepositoriesInput{
 			RepositoryNames: aws.StringSlice([]string{rs.Primary.ID}),
@@ -32,7 +32,7 @@ func testSweepEcsCapacityProviders(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).ecsconn
+	conn := client.(*AWSClient).ecsconn()
 	input := &ecs.DescribeCapacityProvidersInput{}
 	var sweeperErrs *multierror.Error
 
@@ -269,7 +269,7 @@ func TestAccAWSEcsCapacityProvider_Tags(t *testing.T) {
 // TODO add an update test config - Reference: https://github.com/aws/containers-roadmap/issues/633
 
 func testAccCheckAWSEcsCapacityProviderDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_capacity_provider" {
@@ -303,7 +303,7 @@ func testAccCheckAWSEcsCapacityProviderExists(resourceName string, provider *ecs
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DescribeCapacityProvidersInput{
 			CapacityProviders: []*string{aws.String(rs.Primary.ID)},
@@ -27,7 +27,7 @@ func testSweepEfsAccessPoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).efsconn
+	conn := client.(*AWSClient).efsconn()
 
 	var errors error
 	input := &efs.DescribeFileSystemsInput{}
@@ -303,7 +303,7 @@ func TestAccAWSEFSAccessPoint_disappears(t *testing.T) {
 }
 
 func testAccCheckEfsAccessPointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).efsconn
+	conn := testAccProvider.Meta().(*AWSClient).efsconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_access_point" {
 			continue
@@ -342,7 +342,7 @@ func testAccCheckEfsAccessPointExists(resourceID string, mount *efs.AccessPointD
 			return fmt.Errorf("Not found: %s", resourceID)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		mt, err := conn.DescribeAccessPoints(&efs.DescribeAccessPointsInput{
 			AccessPointId: aws.String(fs.Primary.ID),
 		})
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAwsEfsFileSystemPolicy() *schema.Resource {
@@ -30,15 +29,15 @@ func resourceAwsEfsFileSystemPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ValidateFunc:     validateResourcePolicyDocument(iamPolicyTypeResource),
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 		},
 	}
 }
 
 func resourceAwsEfsFileSystemPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	fsId := d.Get("file_system_id").(string)
 	input := &efs.PutFileSystemPolicyInput{
@@ -57,7 +56,7 @@ func resourceAwsEfsFileSystemPolicyPut(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEfsFileSystemPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	var policyRes *efs.DescribeFileSystemPolicyOutput
 	policyRes, err := conn.DescribeFileSystemPolicy(&efs.DescribeFileSystemPolicyInput{
@@ -84,7 +83,7 @@ func resourceAwsEfsFileSystemPolicyRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEfsFileSystemPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	log.Printf("[DEBUG] Deleting EFS File System Policy: %s", d.Id())
 	_, err := conn.DeleteFileSystemPolicy(&efs.DeleteFileSystemPolicyInput{
@@ -67,7 +67,7 @@ func TestAccAWSEFSFileSystemPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckEfsFileSystemPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).efsconn
+	conn := testAccProvider.Meta().(*AWSClient).efsconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_file_system_policy" {
 			continue
@@ -102,7 +102,7 @@ func testAccCheckEfsFileSystemPolicy(resourceID string, efsFsPolicy *efs.Describ
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		fs, err := conn.DescribeFileSystemPolicy(&efs.DescribeFileSystemPolicyInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -34,7 +34,7 @@ func testSweepEc2Eips(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 
 	// There is currently no paginator or Marker/NextToken
 	input := &ec2.DescribeAddressesInput{}
@@ -531,7 +531,7 @@ func TestAccAWSEIP_PublicIpv4Pool_custom(t *testing.T) {
 }
 
 func testAccCheckAWSEIPDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eip" {
@@ -634,7 +634,7 @@ func testAccCheckAWSEIPExists(n string, res *ec2.Address) resource.TestCheckFunc
 			return fmt.Errorf("No EIP ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		if strings.Contains(rs.Primary.ID, "eipalloc") {
 			req := &ec2.DescribeAddressesInput{
@@ -682,7 +682,7 @@ func testAccCheckAWSEIPEc2ClassicExists(n string, res *ec2.Address) resource.Tes
 			return fmt.Errorf("No EIP ID is set")
 		}
 
-		conn := testAccProviderEc2Classic.Meta().(*AWSClient).ec2conn
+		conn := testAccProviderEc2Classic.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DescribeAddressesInput{
 			PublicIps: []*string{aws.String(rs.Primary.ID)},
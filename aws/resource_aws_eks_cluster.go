@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -150,13 +151,15 @@ func resourceAwsEksCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -226,7 +229,7 @@ func resourceAwsEksCluster() *schema.Resource {
 }
 
 func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	name := d.Get("name").(string)
 
 	input := &eks.CreateClusterInput{
@@ -300,8 +303,8 @@ func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).eksconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &eks.DescribeClusterInput{
 		Name: aws.String(d.Id()),
@@ -369,10 +372,10 @@ func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.EksUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -458,7 +461,7 @@ func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEksClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	log.Printf("[DEBUG] Deleting EKS Cluster: %s", d.Id())
 	err := deleteEksCluster(conn, d.Id())
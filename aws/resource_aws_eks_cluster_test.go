@@ -33,7 +33,7 @@ func testSweepEksClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
 
 	var errors error
 	input := &eks.ListClustersInput{}
@@ -498,7 +498,7 @@ func testAccCheckAWSEksClusterExists(resourceName string, cluster *eks.Cluster)
 			return fmt.Errorf("No EKS Cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 		output, err := conn.DescribeCluster(&eks.DescribeClusterInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -526,7 +526,7 @@ func testAccCheckAWSEksClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		// Handle eventual consistency
 		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -575,7 +575,7 @@ func testAccCheckAWSEksClusterNotRecreated(i, j *eks.Cluster) resource.TestCheck
 }
 
 func testAccPreCheckAWSEks(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).eksconn
+	conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 	input := &eks.ListClustersInput{}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsEksFargateProfile() *schema.Resource {
@@ -87,13 +88,15 @@ func resourceAwsEksFargateProfile() *schema.Resource {
 				MinItems: 1,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsEksFargateProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	clusterName := d.Get("cluster_name").(string)
 	fargateProfileName := d.Get("fargate_profile_name").(string)
 	id := fmt.Sprintf("%s:%s", clusterName, fargateProfileName)
@@ -116,25 +119,11 @@ func resourceAwsEksFargateProfileCreate(d *schema.ResourceData, meta interface{}
 	awsMutexKV.Lock(mutexKey)
 	defer awsMutexKV.Unlock(mutexKey)
 
-	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateFargateProfile(input)
-
-		// Retry for IAM eventual consistency on error:
-		// InvalidParameterException: Misconfigured PodExecutionRole Trust Policy; Please add the eks-fargate-pods.amazonaws.com Service Principal
-		if isAWSErr(err, eks.ErrCodeInvalidParameterException, "Misconfigured PodExecutionRole Trust Policy") {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if isResourceTimeoutError(err) {
-		_, err = conn.CreateFargateProfile(input)
-	}
+	// Retry for IAM eventual consistency on error:
+	// InvalidParameterException: Misconfigured PodExecutionRole Trust Policy; Please add the eks-fargate-pods.amazonaws.com Service Principal
+	_, err := tfresource.RetryWhenAwsErrMessageContains(iamwaiter.PropagationTimeout, func() (interface{}, error) {
+		return conn.CreateFargateProfile(input)
+	}, eks.ErrCodeInvalidParameterException, "Misconfigured PodExecutionRole Trust Policy")
 
 	if err != nil {
 		return fmt.Errorf("error creating EKS Fargate Profile (%s): %s", id, err)
@@ -157,8 +146,8 @@ func resourceAwsEksFargateProfileCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEksFargateProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).eksconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	clusterName, fargateProfileName, err := resourceAwsEksFargateProfileParseId(d.Id())
 	if err != nil {
@@ -213,10 +202,10 @@ func resourceAwsEksFargateProfileRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEksFargateProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.EksUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -226,7 +215,7 @@ func resourceAwsEksFargateProfileUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEksFargateProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	clusterName, fargateProfileName, err := resourceAwsEksFargateProfileParseId(d.Id())
 	if err != nil {
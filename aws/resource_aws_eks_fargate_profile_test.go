@@ -28,7 +28,7 @@ func testSweepEksFargateProfiles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
 
 	var errors error
 	input := &eks.ListClustersInput{}
@@ -242,7 +242,7 @@ func testAccCheckAWSEksFargateProfileExists(resourceName string, fargateProfile
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		input := &eks.DescribeFargateProfileInput{
 			ClusterName:        aws.String(clusterName),
@@ -274,7 +274,7 @@ func testAccCheckAWSEksFargateProfileExists(resourceName string, fargateProfile
 }
 
 func testAccCheckAWSEksFargateProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).eksconn
+	conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eks_fargate_profile" {
@@ -307,7 +307,7 @@ func testAccCheckAWSEksFargateProfileDestroy(s *terraform.State) error {
 
 func testAccCheckAWSEksFargateProfileDisappears(fargateProfile *eks.FargateProfile) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		input := &eks.DeleteFargateProfileInput{
 			ClusterName:        fargateProfile.ClusterName,
@@ -209,7 +209,9 @@ func resourceAwsEksNodeGroup() *schema.Resource {
 				MinItems: 1,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -220,7 +222,7 @@ func resourceAwsEksNodeGroup() *schema.Resource {
 }
 
 func resourceAwsEksNodeGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	clusterName := d.Get("cluster_name").(string)
 	nodeGroupName := d.Get("node_group_name").(string)
 
@@ -301,8 +303,8 @@ func resourceAwsEksNodeGroupCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsEksNodeGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).eksconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
 	if err != nil {
@@ -383,7 +385,7 @@ func resourceAwsEksNodeGroupRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEksNodeGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
 	if err != nil {
@@ -476,8 +478,8 @@ func resourceAwsEksNodeGroupUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.EksUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -487,7 +489,7 @@ func resourceAwsEksNodeGroupUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsEksNodeGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
 	if err != nil {
@@ -27,7 +27,7 @@ func testSweepEksFargateNodegroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
 
 	var errors error
 	input := &eks.ListClustersInput{}
@@ -781,7 +781,7 @@ func testAccCheckAWSEksNodeGroupExists(resourceName string, nodeGroup *eks.Nodeg
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		input := &eks.DescribeNodegroupInput{
 			ClusterName:   aws.String(clusterName),
@@ -813,7 +813,7 @@ func testAccCheckAWSEksNodeGroupExists(resourceName string, nodeGroup *eks.Nodeg
 }
 
 func testAccCheckAWSEksNodeGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).eksconn
+	conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eks_node_group" {
@@ -846,7 +846,7 @@ func testAccCheckAWSEksNodeGroupDestroy(s *terraform.State) error {
 
 func testAccCheckAWSEksNodeGroupDisappears(nodeGroup *eks.Nodegroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		input := &eks.DeleteNodegroupInput{
 			ClusterName:   nodeGroup.ClusterName,
@@ -231,7 +231,7 @@ func testAccCheckAWSElasticTranscoderPipelineExists(n string, res *elastictransc
 			return fmt.Errorf("No Pipeline ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn
+		conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn()
 
 		out, err := conn.ReadPipeline(&elastictranscoder.ReadPipelineInput{
 			Id: aws.String(rs.Primary.ID),
@@ -249,7 +249,7 @@ func testAccCheckAWSElasticTranscoderPipelineExists(n string, res *elastictransc
 
 func testAccCheckAWSElasticTranscoderPipelineDisappears(res *elastictranscoder.Pipeline) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn
+		conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn()
 
 		_, err := conn.DeletePipeline(&elastictranscoder.DeletePipelineInput{
 			Id: res.Id,
@@ -260,7 +260,7 @@ func testAccCheckAWSElasticTranscoderPipelineDisappears(res *elastictranscoder.P
 }
 
 func testAccCheckElasticTranscoderPipelineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn
+	conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastictranscoder_pipline" {
@@ -291,7 +291,7 @@ func testAccCheckElasticTranscoderPipelineDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckAWSElasticTranscoder(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn
+	conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn()
 
 	input := &elastictranscoder.ListPipelinesInput{}
 
@@ -30,7 +30,7 @@ func testSweepElasticacheReplicationGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
 
 	err = conn.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{}, func(page *elasticache.DescribeReplicationGroupsOutput, isLast bool) bool {
 		if len(page.ReplicationGroups) == 0 {
@@ -794,7 +794,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Simulate failover so primary is on node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					input := &elasticache.ModifyReplicationGroupInput{
 						ApplyImmediately:   aws.Bool(true),
 						PrimaryClusterId:   aws.String(fmt.Sprintf("%s-003", rName)),
@@ -846,7 +846,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Simulate failover so primary is on node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 					// Must disable automatic failover first
 					var input *elasticache.ModifyReplicationGroupInput = &elasticache.ModifyReplicationGroupInput{
@@ -1006,7 +1006,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 			return fmt.Errorf("No replication group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		rg, err := finder.ReplicationGroupByID(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("ElastiCache error: %w", err)
@@ -1019,7 +1019,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 }
 
 func testAccCheckAWSElasticacheReplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_replication_group" {
@@ -29,7 +29,7 @@ func testSweepElasticSearchDomains(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).esconn
+	conn := client.(*AWSClient).esconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -329,7 +329,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
 		Providers: testAccProviders,
 		CheckDestroy: func(s *terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).esconn
+			conn := testAccProvider.Meta().(*AWSClient).esconn()
 			_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
 				DomainName: aws.String(resourceId),
 			})
@@ -339,7 +339,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Create duplicate
-					conn := testAccProvider.Meta().(*AWSClient).esconn
+					conn := testAccProvider.Meta().(*AWSClient).esconn()
 					_, err := conn.CreateElasticsearchDomain(&elasticsearch.CreateElasticsearchDomainInput{
 						DomainName: aws.String(resourceId),
 						EBSOptions: &elasticsearch.EBSOptions{
@@ -1252,7 +1252,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 			return fmt.Errorf("No ES Domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -1270,7 +1270,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 
 func testAccCheckAWSESDomainNotRecreated(i, j *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 
 		iConfig, err := conn.DescribeElasticsearchDomainConfig(&elasticsearch.DescribeElasticsearchDomainConfigInput{
 			DomainName: i.DomainName,
@@ -1299,7 +1299,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -1317,7 +1317,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckIamServiceLinkedRoleEs(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 	dnsSuffix := testAccProvider.Meta().(*AWSClient).dnsSuffix
 
 	input := &iam.ListRolesInput{
@@ -58,7 +58,7 @@ func TestAccAWSELBAttachment_drift(t *testing.T) {
 	resourceName := "aws_elb.test"
 
 	deregInstance := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		deRegisterInstancesOpts := elb.DeregisterInstancesFromLoadBalancerInput{
 			LoadBalancerName: conf.LoadBalancerName,
@@ -136,7 +136,7 @@ func resourceAwsFmsPolicy() *schema.Resource {
 }
 
 func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	fmsPolicy := &fms.Policy{
 		PolicyName:          aws.String(d.Get("name").(string)),
@@ -183,7 +183,7 @@ func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	var resp *fms.GetPolicyOutput
 	var req = &fms.GetPolicyInput{
@@ -232,7 +232,7 @@ func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	fmsPolicy := &fms.Policy{
 		PolicyName:          aws.String(d.Get("name").(string)),
@@ -267,7 +267,7 @@ func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 	log.Printf("[DEBUG] Delete FMS Policy: %s", d.Id())
 
 	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
@@ -126,7 +126,7 @@ func TestAccAWSFmsPolicy_tags(t *testing.T) {
 }
 
 func testAccCheckAwsFmsPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fms_policy" {
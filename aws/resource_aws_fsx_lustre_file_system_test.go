@@ -27,7 +27,7 @@ func testSweepFSXLustreFileSystems(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).fsxconn
+	conn := client.(*AWSClient).fsxconn()
 	input := &fsx.DescribeFileSystemsInput{}
 
 	err = conn.DescribeFileSystemsPages(input, func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
@@ -689,7 +689,7 @@ func testAccCheckFsxLustreFileSystemExists(resourceName string, fs *fsx.FileSyst
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 		filesystem, err := describeFsxFileSystem(conn, rs.Primary.ID)
 
@@ -708,7 +708,7 @@ func testAccCheckFsxLustreFileSystemExists(resourceName string, fs *fsx.FileSyst
 }
 
 func testAccCheckFsxLustreFileSystemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).fsxconn
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_lustre_file_system" {
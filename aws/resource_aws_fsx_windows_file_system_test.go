@@ -25,7 +25,7 @@ func testSweepFSXWindowsFileSystems(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).fsxconn
+	conn := client.(*AWSClient).fsxconn()
 	input := &fsx.DescribeFileSystemsInput{}
 
 	err = conn.DescribeFileSystemsPages(input, func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
@@ -695,7 +695,7 @@ func testAccCheckFsxWindowsFileSystemExists(resourceName string, fs *fsx.FileSys
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 		filesystem, err := describeFsxFileSystem(conn, rs.Primary.ID)
 
@@ -714,7 +714,7 @@ func testAccCheckFsxWindowsFileSystemExists(resourceName string, fs *fsx.FileSys
 }
 
 func testAccCheckFsxWindowsFileSystemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).fsxconn
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_windows_file_system" {
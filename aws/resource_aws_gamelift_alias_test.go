@@ -28,7 +28,7 @@ func testSweepGameliftAliases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
 
 	err = listGameliftAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
 		if len(resp.Aliases) == 0 {
@@ -278,7 +278,7 @@ func TestAccAWSGameliftAlias_disappears(t *testing.T) {
 
 func testAccCheckAWSGameliftAliasDisappears(res *gamelift.Alias) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		input := &gamelift.DeleteAliasInput{AliasId: res.AliasId}
 
@@ -299,7 +299,7 @@ func testAccCheckAWSGameliftAliasExists(n string, res *gamelift.Alias) resource.
 			return fmt.Errorf("No Gamelift Alias ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		out, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
 			AliasId: aws.String(rs.Primary.ID),
@@ -320,7 +320,7 @@ func testAccCheckAWSGameliftAliasExists(n string, res *gamelift.Alias) resource.
 }
 
 func testAccCheckAWSGameliftAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_alias" {
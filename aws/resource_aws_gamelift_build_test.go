@@ -27,7 +27,7 @@ func testSweepGameliftBuilds(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
 
 	resp, err := conn.ListBuilds(&gamelift.ListBuildsInput{})
 	if err != nil {
@@ -242,7 +242,7 @@ func testAccCheckAWSGameliftBuildExists(n string, res *gamelift.Build) resource.
 			return fmt.Errorf("No Gamelift Build ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		req := &gamelift.DescribeBuildInput{
 			BuildId: aws.String(rs.Primary.ID),
@@ -266,7 +266,7 @@ func testAccCheckAWSGameliftBuildExists(n string, res *gamelift.Build) resource.
 
 func testAccCheckAWSGameliftBuildDisappears(res *gamelift.Build) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		input := &gamelift.DeleteBuildInput{BuildId: res.BuildId}
 
@@ -276,7 +276,7 @@ func testAccCheckAWSGameliftBuildDisappears(res *gamelift.Build) resource.TestCh
 }
 
 func testAccCheckAWSGameliftBuildDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_build" {
@@ -303,7 +303,7 @@ func testAccCheckAWSGameliftBuildDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckAWSGamelift(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	input := &gamelift.ListBuildsInput{}
 
@@ -39,7 +39,7 @@ func resourceAwsGlacierVaultLock() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMPolicyDocument(iamPolicyTypeResource),
 			},
 			"vault_name": {
 				Type:         schema.TypeString,
@@ -52,7 +52,7 @@ func resourceAwsGlacierVaultLock() *schema.Resource {
 }
 
 func resourceAwsGlacierVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glacierconn
+	conn := meta.(*AWSClient).glacierconn()
 	vaultName := d.Get("vault_name").(string)
 
 	input := &glacier.InitiateVaultLockInput{
@@ -93,7 +93,7 @@ func resourceAwsGlacierVaultLockCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGlacierVaultLockRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glacierconn
+	conn := meta.(*AWSClient).glacierconn()
 
 	input := &glacier.GetVaultLockInput{
 		AccountId: aws.String("-"),
@@ -127,7 +127,7 @@ func resourceAwsGlacierVaultLockRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlacierVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glacierconn
+	conn := meta.(*AWSClient).glacierconn()
 
 	input := &glacier.AbortVaultLockInput{
 		VaultName: aws.String(d.Id()),
@@ -84,7 +84,7 @@ func testAccCheckGlacierVaultLockExists(resourceName string, getVaultLockOutput
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glacierconn
+		conn := testAccProvider.Meta().(*AWSClient).glacierconn()
 
 		input := &glacier.GetVaultLockInput{
 			VaultName: aws.String(rs.Primary.ID),
@@ -106,7 +106,7 @@ func testAccCheckGlacierVaultLockExists(resourceName string, getVaultLockOutput
 }
 
 func testAccCheckGlacierVaultLockDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glacierconn
+	conn := testAccProvider.Meta().(*AWSClient).glacierconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_glacier_vault_lock" {
@@ -27,7 +27,7 @@ func testSweepGlobalAcceleratorAccelerators(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).globalacceleratorconn
+	conn := client.(*AWSClient).globalacceleratorconn()
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
 	var sweeperErrs *multierror.Error
@@ -319,7 +319,7 @@ func TestAccAwsGlobalAcceleratorAccelerator_tags(t *testing.T) {
 }
 
 func testAccPreCheckGlobalAccelerator(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
 
@@ -336,7 +336,7 @@ func testAccPreCheckGlobalAccelerator(t *testing.T) {
 
 func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -361,7 +361,7 @@ func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCh
 }
 
 func testAccCheckGlobalAcceleratorAcceleratorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_globalaccelerator_accelerator" {
@@ -87,7 +87,7 @@ func resourceAwsGlueConnection() *schema.Resource {
 }
 
 func resourceAwsGlueConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	var catalogID string
 	if v, ok := d.GetOkExists("catalog_id"); ok {
 		catalogID = v.(string)
@@ -113,7 +113,7 @@ func resourceAwsGlueConnectionCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
@@ -170,7 +170,7 @@ func resourceAwsGlueConnectionRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlueConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
@@ -193,7 +193,7 @@ func resourceAwsGlueConnectionUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
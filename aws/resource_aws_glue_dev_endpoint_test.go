@@ -35,7 +35,7 @@ func testSweepGlueDevEndpoint(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	input := &glue.GetDevEndpointsInput{}
 	err = conn.GetDevEndpointsPages(input, func(page *glue.GetDevEndpointsOutput, lastPage bool) bool {
@@ -588,7 +588,7 @@ func testAccCheckAWSGlueDevEndpointExists(resourceName string, endpoint *glue.De
 			return fmt.Errorf("no ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := conn.GetDevEndpoint(&glue.GetDevEndpointInput{
 			EndpointName: aws.String(rs.Primary.ID),
 		})
@@ -613,7 +613,7 @@ func testAccCheckAWSGlueDevEndpointDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := conn.GetDevEndpoint(&glue.GetDevEndpointInput{
 			EndpointName: aws.String(rs.Primary.ID),
 		})
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/waiter"
 )
@@ -124,9 +125,11 @@ func resourceAwsGlueMLTransform() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"timeout": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -171,7 +174,7 @@ func resourceAwsGlueMLTransform() *schema.Resource {
 }
 
 func resourceAwsGlueMLTransformCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	input := &glue.CreateMLTransformInput{
 		Name:              aws.String(d.Get("name").(string)),
@@ -218,8 +221,8 @@ func resourceAwsGlueMLTransformCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGlueMLTransformRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).glueconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &glue.GetMLTransformInput{
 		TransformId: aws.String(d.Id()),
@@ -290,7 +293,7 @@ func resourceAwsGlueMLTransformRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGlueMLTransformUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	if d.HasChanges("description", "glue_version", "max_capacity", "max_retries", "number_of_workers",
 		"role_arn", "timeout", "worker_type", "parameters") {
@@ -336,8 +339,8 @@ func resourceAwsGlueMLTransformUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.GlueUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %w", err)
 		}
@@ -347,7 +350,7 @@ func resourceAwsGlueMLTransformUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGlueMLTransformDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	log.Printf("[DEBUG] Deleting Glue ML Trasform: %s", d.Id())
 
@@ -194,7 +194,7 @@ func resourceAwsGluePartition() *schema.Resource {
 }
 
 func resourceAwsGluePartitionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
 	dbName := d.Get("database_name").(string)
 	tableName := d.Get("table_name").(string)
@@ -219,7 +219,7 @@ func resourceAwsGluePartitionCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGluePartitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, dbName, tableName, values, err := tfglue.ReadAwsGluePartitionID(d.Id())
 	if err != nil {
@@ -277,7 +277,7 @@ func resourceAwsGluePartitionRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsGluePartitionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, dbName, tableName, values, err := tfglue.ReadAwsGluePartitionID(d.Id())
 	if err != nil {
@@ -301,7 +301,7 @@ func resourceAwsGluePartitionUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGluePartitionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, dbName, tableName, values, tableErr := tfglue.ReadAwsGluePartitionID(d.Id())
 	if tableErr != nil {
@@ -140,7 +140,7 @@ func TestAccAWSGluePartition_disappears(t *testing.T) {
 }
 
 func testAccCheckGluePartitionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_glue_partition" {
@@ -186,7 +186,7 @@ func testAccCheckGluePartitionExists(name string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		out, err := conn.GetPartition(&glue.GetPartitionInput{
 			DatabaseName:    aws.String(dbName),
 			CatalogId:       aws.String(catalogID),
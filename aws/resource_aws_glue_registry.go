@@ -44,13 +44,15 @@ func resourceAwsGlueRegistry() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`[a-zA-Z0-9-_$#]+$`), ""),
 				),
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsGlueRegistryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	input := &glue.CreateRegistryInput{
 		RegistryName: aws.String(d.Get("registry_name").(string)),
@@ -72,8 +74,8 @@ func resourceAwsGlueRegistryCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlueRegistryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).glueconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	output, err := finder.RegistryByID(conn, d.Id())
 	if err != nil {
@@ -110,7 +112,7 @@ func resourceAwsGlueRegistryRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsGlueRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	if d.HasChanges("description") {
 		input := &glue.UpdateRegistryInput{
@@ -128,8 +130,8 @@ func resourceAwsGlueRegistryUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.GlueUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -139,7 +141,7 @@ func resourceAwsGlueRegistryUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlueRegistryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	log.Printf("[DEBUG] Deleting Glue Registry: %s", d.Id())
 	input := &glue.DeleteRegistryInput{
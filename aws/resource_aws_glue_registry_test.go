@@ -25,7 +25,7 @@ func testSweepGlueRegistry(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	listOutput, err := conn.ListRegistries(&glue.ListRegistriesInput{})
 	if err != nil {
@@ -182,7 +182,7 @@ func TestAccAWSGlueRegistry_disappears(t *testing.T) {
 }
 
 func testAccPreCheckAWSGlueRegistry(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	_, err := conn.ListRegistries(&glue.ListRegistriesInput{})
 
@@ -207,7 +207,7 @@ func testAccCheckAWSGlueRegistryExists(resourceName string, registry *glue.GetRe
 			return fmt.Errorf("No Glue Registry ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := finder.RegistryByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -232,7 +232,7 @@ func testAccCheckAWSGlueRegistryDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := finder.RegistryByID(conn, rs.Primary.ID)
 		if err != nil {
 			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
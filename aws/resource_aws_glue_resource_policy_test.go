@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
 func CreateTablePolicy(action string) string {
@@ -133,7 +132,7 @@ func testAccAWSGlueResourcePolicy(n string, action string) resource.TestCheckFun
 			return fmt.Errorf("No policy id set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		policy, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
 		if err != nil {
@@ -143,7 +142,7 @@ func testAccAWSGlueResourcePolicy(n string, action string) resource.TestCheckFun
 		actualPolicyText := *policy.PolicyInJson
 
 		expectedPolicy := CreateTablePolicy(action)
-		equivalent, err := awspolicy.PoliciesAreEquivalent(actualPolicyText, expectedPolicy)
+		equivalent, err := iamPoliciesAreEquivalent(actualPolicyText, expectedPolicy)
 		if err != nil {
 			return fmt.Errorf("Error testing policy equivalence: %s", err)
 		}
@@ -157,7 +156,7 @@ func testAccAWSGlueResourcePolicy(n string, action string) resource.TestCheckFun
 }
 
 func testAccCheckAWSGlueResourcePolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	policy, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
 
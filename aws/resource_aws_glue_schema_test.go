@@ -25,7 +25,7 @@ func testSweepGlueSchema(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	listOutput, err := conn.ListSchemas(&glue.ListSchemasInput{})
 	if err != nil {
@@ -286,7 +286,7 @@ func TestAccAWSGlueSchema_disappears_registry(t *testing.T) {
 }
 
 func testAccPreCheckAWSGlueSchema(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	_, err := conn.ListRegistries(&glue.ListRegistriesInput{})
 
@@ -311,7 +311,7 @@ func testAccCheckAWSGlueSchemaExists(resourceName string, schema *glue.GetSchema
 			return fmt.Errorf("No Glue Schema ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := finder.SchemaByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -336,7 +336,7 @@ func testAccCheckAWSGlueSchemaDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := finder.SchemaByID(conn, rs.Primary.ID)
 		if err != nil {
 			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
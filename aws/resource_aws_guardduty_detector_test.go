@@ -28,7 +28,7 @@ func testSweepGuarddutyDetectors(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).guarddutyconn
+	conn := client.(*AWSClient).guarddutyconn()
 	input := &guardduty.ListDetectorsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -159,7 +159,7 @@ func testAccAwsGuardDutyDetector_tags(t *testing.T) {
 }
 
 func testAccCheckAwsGuardDutyDetectorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_detector" {
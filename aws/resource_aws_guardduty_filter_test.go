@@ -185,7 +185,7 @@ func testAccAwsGuardDutyFilter_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsGuardDutyFilterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_filter" {
@@ -232,7 +232,7 @@ func testAccCheckAwsGuardDutyFilterExists(name string, filter *guardduty.GetFilt
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 		input := guardduty.GetFilterInput{
 			DetectorId: aws.String(detectorID),
 			FilterName: aws.String(name),
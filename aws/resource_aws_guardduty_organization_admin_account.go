@@ -32,7 +32,7 @@ func resourceAwsGuardDutyOrganizationAdminAccount() *schema.Resource {
 }
 
 func resourceAwsGuardDutyOrganizationAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	adminAccountID := d.Get("admin_account_id").(string)
 
@@ -56,7 +56,7 @@ func resourceAwsGuardDutyOrganizationAdminAccountCreate(d *schema.ResourceData,
 }
 
 func resourceAwsGuardDutyOrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	adminAccount, err := getGuardDutyOrganizationAdminAccount(conn, d.Id())
 
@@ -76,7 +76,7 @@ func resourceAwsGuardDutyOrganizationAdminAccountRead(d *schema.ResourceData, me
 }
 
 func resourceAwsGuardDutyOrganizationAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	input := &guardduty.DisableOrganizationAdminAccountInput{
 		AdminAccountId: aws.String(d.Id()),
@@ -89,7 +89,9 @@ func resourceAwsImageBuilderComponent() *schema.Resource {
 				MinItems: 1,
 				MaxItems: 25,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -111,7 +113,7 @@ func resourceAwsImageBuilderComponent() *schema.Resource {
 }
 
 func resourceAwsImageBuilderComponentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.CreateComponentInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -173,8 +175,8 @@ func resourceAwsImageBuilderComponentCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsImageBuilderComponentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).imagebuilderconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &imagebuilder.GetComponentInput{
 		ComponentBuildVersionArn: aws.String(d.Id()),
@@ -221,10 +223,10 @@ func resourceAwsImageBuilderComponentRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsImageBuilderComponentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags for Image Builder Component (%s): %w", d.Id(), err)
@@ -235,7 +237,7 @@ func resourceAwsImageBuilderComponentUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsImageBuilderComponentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.DeleteComponentInput{
 		ComponentBuildVersionArn: aws.String(d.Id()),
@@ -27,7 +27,7 @@ func testSweepImageBuilderComponents(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
+	conn := client.(*AWSClient).imagebuilderconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -356,7 +356,7 @@ func TestAccAwsImageBuilderComponent_Uri(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderComponentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_component" {
@@ -392,7 +392,7 @@ func testAccCheckAwsImageBuilderComponentExists(resourceName string) resource.Te
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetComponentInput{
 			ComponentBuildVersionArn: aws.String(rs.Primary.ID),
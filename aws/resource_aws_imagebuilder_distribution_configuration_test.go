@@ -26,7 +26,7 @@ func testSweepImageBuilderDistributionConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
+	conn := client.(*AWSClient).imagebuilderconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -524,7 +524,7 @@ func TestAccAwsImageBuilderDistributionConfiguration_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderDistributionConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_distribution_configuration" {
@@ -560,7 +560,7 @@ func testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName str
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetDistributionConfigurationInput{
 			DistributionConfigurationArn: aws.String(rs.Primary.ID),
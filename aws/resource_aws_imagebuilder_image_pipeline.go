@@ -127,13 +127,15 @@ func resourceAwsImageBuilderImagePipeline() *schema.Resource {
 				Default:      imagebuilder.PipelineStatusEnabled,
 				ValidateFunc: validation.StringInSlice(imagebuilder.PipelineStatus_Values(), false),
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsImageBuilderImagePipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.CreateImagePipelineInput{
 		ClientToken:                  aws.String(resource.UniqueId()),
@@ -192,7 +194,7 @@ func resourceAwsImageBuilderImagePipelineCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsImageBuilderImagePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.GetImagePipelineInput{
 		ImagePipelineArn: aws.String(d.Id()),
@@ -243,13 +245,13 @@ func resourceAwsImageBuilderImagePipelineRead(d *schema.ResourceData, meta inter
 	}
 
 	d.Set("status", imagePipeline.Status)
-	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(imagePipeline.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).IgnoreTagsConfig).Map())
+	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(imagePipeline.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).ResourceIgnoreTagsConfig(d)).Map())
 
 	return nil
 }
 
 func resourceAwsImageBuilderImagePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	if d.HasChanges(
 		"description",
@@ -302,8 +304,8 @@ func resourceAwsImageBuilderImagePipelineUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags for Image Builder Image Pipeline (%s): %w", d.Id(), err)
@@ -314,7 +316,7 @@ func resourceAwsImageBuilderImagePipelineUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsImageBuilderImagePipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.DeleteImagePipelineInput{
 		ImagePipelineArn: aws.String(d.Id()),
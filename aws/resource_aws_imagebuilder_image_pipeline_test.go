@@ -26,7 +26,7 @@ func testSweepImageBuilderImagePipelines(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
+	conn := client.(*AWSClient).imagebuilderconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -515,7 +515,7 @@ func TestAccAwsImageBuilderImagePipeline_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderImagePipelineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_image_pipeline" {
@@ -551,7 +551,7 @@ func testAccCheckAwsImageBuilderImagePipelineExists(resourceName string) resourc
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetImagePipelineInput{
 			ImagePipelineArn: aws.String(rs.Primary.ID),
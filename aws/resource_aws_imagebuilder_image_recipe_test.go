@@ -27,7 +27,7 @@ func testSweepImageBuilderImageRecipes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
+	conn := client.(*AWSClient).imagebuilderconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -532,7 +532,7 @@ func TestAccAwsImageBuilderImageRecipe_WorkingDirectory(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderImageRecipeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_image_recipe" {
@@ -568,7 +568,7 @@ func testAccCheckAwsImageBuilderImageRecipeExists(resourceName string) resource.
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetImageRecipeInput{
 			ImageRecipeArn: aws.String(rs.Primary.ID),
@@ -110,7 +110,9 @@ func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1024),
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"terminate_instance_on_failure": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -121,7 +123,7 @@ func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
 }
 
 func resourceAwsImageBuilderInfrastructureConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.CreateInfrastructureConfigurationInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -175,31 +177,16 @@ func resourceAwsImageBuilderInfrastructureConfigurationCreate(d *schema.Resource
 		input.TerminateInstanceOnFailure = aws.Bool(v.(bool))
 	}
 
-	var output *imagebuilder.CreateInfrastructureConfigurationOutput
-	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
-		var err error
-
-		output, err = conn.CreateInfrastructureConfiguration(input)
-
-		if tfawserr.ErrMessageContains(err, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist") {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		output, err = conn.CreateInfrastructureConfiguration(input)
-	}
+	outputRaw, err := tfresource.RetryWhenAwsErrMessageContains(iamwaiter.PropagationTimeout, func() (interface{}, error) {
+		return conn.CreateInfrastructureConfiguration(input)
+	}, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist")
 
 	if err != nil {
 		return fmt.Errorf("error creating Image Builder Infrastructure Configuration: %w", err)
 	}
 
+	output, _ := outputRaw.(*imagebuilder.CreateInfrastructureConfigurationOutput)
+
 	if output == nil {
 		return fmt.Errorf("error creating Image Builder Infrastructure Configuration: empty response")
 	}
@@ -210,8 +197,8 @@ func resourceAwsImageBuilderInfrastructureConfigurationCreate(d *schema.Resource
 }
 
 func resourceAwsImageBuilderInfrastructureConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).imagebuilderconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &imagebuilder.GetInfrastructureConfigurationInput{
 		InfrastructureConfigurationArn: aws.String(d.Id()),
@@ -259,7 +246,7 @@ func resourceAwsImageBuilderInfrastructureConfigurationRead(d *schema.ResourceDa
 }
 
 func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	if d.HasChanges(
 		"description",
@@ -317,31 +304,17 @@ func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.Resource
 			input.TerminateInstanceOnFailure = aws.Bool(v.(bool))
 		}
 
-		err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateInfrastructureConfiguration(input)
-
-			if tfawserr.ErrMessageContains(err, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist") {
-				return resource.RetryableError(err)
-			}
-
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-
-			return nil
-		})
-
-		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateInfrastructureConfiguration(input)
-		}
+		_, err := tfresource.RetryWhenAwsErrMessageContains(iamwaiter.PropagationTimeout, func() (interface{}, error) {
+			return conn.UpdateInfrastructureConfiguration(input)
+		}, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist")
 
 		if err != nil {
 			return fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags for Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
@@ -352,7 +325,7 @@ func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.Resource
 }
 
 func resourceAwsImageBuilderInfrastructureConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.DeleteInfrastructureConfigurationInput{
 		InfrastructureConfigurationArn: aws.String(d.Id()),
@@ -26,7 +26,7 @@ func testSweepImageBuilderInfrastructureConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
+	conn := client.(*AWSClient).imagebuilderconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -565,7 +565,7 @@ func TestAccAwsImageBuilderInfrastructureConfiguration_TerminateInstanceOnFailur
 }
 
 func testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_infrastructure_configuration" {
@@ -601,7 +601,7 @@ func testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName s
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetInfrastructureConfigurationInput{
 			InfrastructureConfigurationArn: aws.String(rs.Primary.ID),
@@ -44,7 +44,7 @@ func resourceAwsIotPolicy() *schema.Resource {
 
 func resourceAwsIotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	out, err := conn.CreatePolicy(&iot.CreatePolicyInput{
 		PolicyName:     aws.String(d.Get("name").(string)),
@@ -61,7 +61,7 @@ func resourceAwsIotPolicyCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	out, err := conn.GetPolicy(&iot.GetPolicyInput{
 		PolicyName: aws.String(d.Id()),
@@ -86,7 +86,7 @@ func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	if d.HasChange("policy") {
 		_, err := conn.CreatePolicyVersion(&iot.CreatePolicyVersionInput{
@@ -105,7 +105,7 @@ func resourceAwsIotPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAwsIotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	out, err := conn.ListPolicyVersions(&iot.ListPolicyVersionsInput{
 		PolicyName: aws.String(d.Id()),
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iot/lister"
 )
 
 func resourceAwsIotPolicyAttachment() *schema.Resource {
@@ -30,7 +31,7 @@ func resourceAwsIotPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	policyName := d.Get("policy").(string)
 	target := d.Get("target").(string)
@@ -48,24 +49,6 @@ func resourceAwsIotPolicyAttachmentCreate(d *schema.ResourceData, meta interface
 	return resourceAwsIotPolicyAttachmentRead(d, meta)
 }
 
-func listIotPolicyAttachmentPages(conn *iot.IoT, input *iot.ListAttachedPoliciesInput,
-	fn func(out *iot.ListAttachedPoliciesOutput, lastPage bool) bool) error {
-	for {
-		page, err := conn.ListAttachedPolicies(input)
-		if err != nil {
-			return err
-		}
-		lastPage := page.NextMarker == nil
-
-		shouldContinue := fn(page, lastPage)
-		if !shouldContinue || lastPage {
-			break
-		}
-		input.Marker = page.NextMarker
-	}
-	return nil
-}
-
 func getIotPolicyAttachment(conn *iot.IoT, target, policyName string) (*iot.Policy, error) {
 	var policy *iot.Policy
 
@@ -75,7 +58,7 @@ func getIotPolicyAttachment(conn *iot.IoT, target, policyName string) (*iot.Poli
 		Target:    aws.String(target),
 	}
 
-	err := listIotPolicyAttachmentPages(conn, input, func(out *iot.ListAttachedPoliciesOutput, lastPage bool) bool {
+	err := lister.ListAttachedPoliciesPages(conn, input, func(out *iot.ListAttachedPoliciesOutput, lastPage bool) bool {
 		for _, att := range out.Policies {
 			if policyName == aws.StringValue(att.PolicyName) {
 				policy = att
@@ -89,7 +72,7 @@ func getIotPolicyAttachment(conn *iot.IoT, target, policyName string) (*iot.Poli
 }
 
 func resourceAwsIotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	policyName := d.Get("policy").(string)
 	target := d.Get("target").(string)
@@ -112,7 +95,7 @@ func resourceAwsIotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsIotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	policyName := d.Get("policy").(string)
 	target := d.Get("target").(string)
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iot/lister"
 )
 
 func TestAccAWSIotPolicyAttachment_basic(t *testing.T) {
@@ -57,7 +58,7 @@ func TestAccAWSIotPolicyAttachment_basic(t *testing.T) {
 }
 
 func testAccCheckAWSIotPolicyAttchmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_policy_attachment" {
 			continue
@@ -73,7 +74,7 @@ func testAccCheckAWSIotPolicyAttchmentDestroy(s *terraform.State) error {
 		}
 
 		var policy *iot.Policy
-		err := listIotPolicyAttachmentPages(conn, input, func(out *iot.ListAttachedPoliciesOutput, lastPage bool) bool {
+		err := lister.ListAttachedPoliciesPages(conn, input, func(out *iot.ListAttachedPoliciesOutput, lastPage bool) bool {
 			for _, att := range out.Policies {
 				if policyName == aws.StringValue(att.PolicyName) {
 					policy = att
@@ -109,7 +110,7 @@ func testAccCheckAWSIotPolicyAttachmentExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No policy name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 		target := rs.Primary.Attributes["target"]
 		policyName := rs.Primary.Attributes["policy"]
 
@@ -129,7 +130,7 @@ func testAccCheckAWSIotPolicyAttachmentExists(n string) resource.TestCheckFunc {
 
 func testAccCheckAWSIotPolicyAttachmentCertStatus(n string, policies []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 		rs, ok := s.RootModule().Resources[n]
 
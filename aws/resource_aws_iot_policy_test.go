@@ -81,7 +81,7 @@ func TestAccAWSIoTPolicy_invalidJson(t *testing.T) {
 }
 
 func testAccCheckAWSIoTPolicyDestroy_basic(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_policy" {
@@ -124,7 +124,7 @@ func testAccCheckAWSIoTPolicyExists(n string, v *iot.GetPolicyOutput) resource.T
 			return fmt.Errorf("No IoT Policy ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 		resp, err := conn.GetPolicy(&iot.GetPolicyInput{
 			PolicyName: aws.String(rs.Primary.ID),
@@ -31,7 +31,7 @@ func resourceAwsIotThingPrincipalAttachment() *schema.Resource {
 }
 
 func resourceAwsIotThingPrincipalAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	principal := d.Get("principal").(string)
 	thing := d.Get("thing").(string)
@@ -69,7 +69,7 @@ func getIoTThingPricipalAttachment(conn *iot.IoT, thing, principal string) (bool
 }
 
 func resourceAwsIotThingPrincipalAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	principal := d.Get("principal").(string)
 	thing := d.Get("thing").(string)
@@ -89,7 +89,7 @@ func resourceAwsIotThingPrincipalAttachmentRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsIotThingPrincipalAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	principal := d.Get("principal").(string)
 	thing := d.Get("thing").(string)
@@ -74,7 +74,7 @@ func resourceAwsIotThingType() *schema.Resource {
 }
 
 func resourceAwsIotThingTypeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.CreateThingTypeInput{
 		ThingTypeName: aws.String(d.Get("name").(string)),
@@ -116,7 +116,7 @@ func resourceAwsIotThingTypeCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotThingTypeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.DescribeThingTypeInput{
 		ThingTypeName: aws.String(d.Id()),
@@ -146,7 +146,7 @@ func resourceAwsIotThingTypeRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIotThingTypeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	if d.HasChange("deprecated") {
 		params := &iot.DeprecateThingTypeInput{
@@ -166,7 +166,7 @@ func resourceAwsIotThingTypeUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotThingTypeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	// In order to delete an IoT Thing Type, you must deprecate it first and wait
 	// at least 5 minutes.
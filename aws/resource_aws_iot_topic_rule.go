@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -38,7 +39,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 						"state_reason": {
 							Type:     schema.TypeString,
@@ -81,7 +82,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 					},
 				},
@@ -135,7 +136,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 						"table_name": {
 							Type:     schema.TypeString,
@@ -165,7 +166,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 					},
 				},
@@ -191,7 +192,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -216,7 +217,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 						"separator": {
 							Type:         schema.TypeString,
@@ -238,7 +239,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 					},
 				},
@@ -259,7 +260,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 					},
 				},
@@ -276,7 +277,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 						"stream_name": {
 							Type:     schema.TypeString,
@@ -318,7 +319,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 						"topic": {
 							Type:     schema.TypeString,
@@ -343,7 +344,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 					},
 				},
@@ -364,7 +365,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 					},
 				},
@@ -387,7 +388,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 					},
 				},
@@ -412,7 +413,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 						"use_base64": {
 							Type:     schema.TypeBool,
@@ -421,7 +422,9 @@ func resourceAwsIotTopicRule() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"error_action": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -441,7 +444,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 									"state_reason": {
 										Type:     schema.TypeString,
@@ -502,7 +505,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 								},
 							},
@@ -570,7 +573,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 									"table_name": {
 										Type:     schema.TypeString,
@@ -618,7 +621,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 								},
 							},
@@ -662,7 +665,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 									"type": {
 										Type:     schema.TypeString,
@@ -701,7 +704,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 									"separator": {
 										Type:         schema.TypeString,
@@ -741,7 +744,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 								},
 							},
@@ -780,7 +783,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 								},
 							},
@@ -815,7 +818,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 									"stream_name": {
 										Type:     schema.TypeString,
@@ -887,7 +890,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 									"topic": {
 										Type:     schema.TypeString,
@@ -930,7 +933,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 								},
 							},
@@ -969,7 +972,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 								},
 							},
@@ -1010,7 +1013,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 								},
 							},
@@ -1045,7 +1048,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 									"use_base64": {
 										Type:     schema.TypeBool,
@@ -1079,7 +1082,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 }
 
 func resourceAwsIotTopicRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	ruleName := d.Get("name").(string)
 
@@ -1101,8 +1104,8 @@ func resourceAwsIotTopicRuleCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotTopicRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).iotconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &iot.GetTopicRuleInput{
 		RuleName: aws.String(d.Id()),
@@ -1199,7 +1202,7 @@ func resourceAwsIotTopicRuleRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIotTopicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	if d.HasChanges(
 		"cloudwatch_alarm",
@@ -1234,8 +1237,8 @@ func resourceAwsIotTopicRuleUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.IotUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
@@ -1246,7 +1249,7 @@ func resourceAwsIotTopicRuleUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotTopicRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	input := &iot.DeleteTopicRuleInput{
 		RuleName: aws.String(d.Id()),
@@ -25,7 +25,7 @@ func testSweepIotTopicRules(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).iotconn
+	conn := client.(*AWSClient).iotconn()
 	input := &iot.ListTopicRulesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -567,7 +567,7 @@ func TestAccAWSIoTTopicRule_errorAction(t *testing.T) {
 }
 
 func testAccCheckAWSIoTTopicRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_topic_rule" {
@@ -600,7 +600,7 @@ func testAccCheckAWSIoTTopicRuleExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 		input := &iot.ListTopicRulesInput{}
 
 		output, err := conn.ListTopicRules(input)
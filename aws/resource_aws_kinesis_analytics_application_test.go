@@ -29,7 +29,7 @@ func testSweepKinesisAnalyticsApplications(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).kinesisanalyticsconn
+	conn := client.(*AWSClient).kinesisanalyticsconn()
 	input := &kinesisanalytics.ListApplicationsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -1482,7 +1482,7 @@ func TestAccAWSKinesisAnalyticsApplication_ReferenceDataSource_Update(t *testing
 }
 
 func testAccCheckKinesisAnalyticsApplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kinesis_analytics_application" {
@@ -1513,7 +1513,7 @@ func testAccCheckKinesisAnalyticsApplicationExists(n string, v *kinesisanalytics
 			return fmt.Errorf("No Kinesis Analytics Application ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn()
 
 		application, err := finder.ApplicationByName(conn, rs.Primary.Attributes["name"])
 		if err != nil {
@@ -1527,7 +1527,7 @@ func testAccCheckKinesisAnalyticsApplicationExists(n string, v *kinesisanalytics
 }
 
 func testAccPreCheckAWSKinesisAnalytics(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn()
 
 	input := &kinesisanalytics.ListApplicationsInput{}
 
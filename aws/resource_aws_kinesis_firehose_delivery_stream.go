@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -136,7 +137,7 @@ func s3ConfigurationSchema() *schema.Schema {
 				"role_arn": {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: validateArn,
+					ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 				},
 
 				"prefix": {
@@ -821,7 +822,10 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"ignore_tags": ignoreTagsSchema(),
 
 			"server_side_encryption": {
 				Type:             schema.TypeList,
@@ -874,7 +878,7 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 					},
 				},
@@ -1159,7 +1163,7 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 												"role_arn": {
 													Type:         schema.TypeString,
 													Required:     true,
-													ValidateFunc: validateArn,
+													ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 												},
 												"table_name": {
 													Type:     schema.TypeString,
@@ -1191,7 +1195,7 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 
 						"prefix": {
@@ -1242,7 +1246,7 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 
 						"s3_backup_mode": {
@@ -1330,7 +1334,7 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 
 						"s3_backup_mode": {
@@ -1374,7 +1378,7 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 										Type:         schema.TypeString,
 										Required:     true,
 										ForceNew:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 									},
 								},
 							},
@@ -1482,7 +1486,7 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 						},
 
 						"s3_backup_mode": {
@@ -2460,7 +2464,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamCreate(d *schema.ResourceData, meta
 		return validateError
 	}
 
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).firehoseconn()
 
 	sn := d.Get("name").(string)
 
@@ -2616,7 +2620,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 		return validateError
 	}
 
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).firehoseconn()
 
 	sn := d.Get("name").(string)
 	updateInput := &firehose.UpdateDestinationInput{
@@ -2700,8 +2704,8 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 			sn, err)
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.FirehoseUpdateTags(conn, sn, o, n); err != nil {
 			return fmt.Errorf("error updating Kinesis Firehose Delivery Stream (%s) tags: %s", sn, err)
@@ -2745,8 +2749,8 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).firehoseconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).firehoseconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	sn := d.Get("name").(string)
 	resp, err := conn.DescribeDeliveryStream(&firehose.DescribeDeliveryStreamInput{
@@ -2782,7 +2786,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsKinesisFirehoseDeliveryStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).firehoseconn()
 
 	sn := d.Get("name").(string)
 	_, err := conn.DeleteDeliveryStream(&firehose.DeleteDeliveryStreamInput{
@@ -28,7 +28,7 @@ func testSweepKinesisFirehoseDeliveryStreams(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).firehoseconn
+	conn := client.(*AWSClient).firehoseconn()
 	input := &firehose.ListDeliveryStreamsInput{}
 
 	for {
@@ -555,7 +555,7 @@ func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ExternalUpdate(t *testin
 			},
 			{
 				PreConfig: func() {
-					conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+					conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 					udi := firehose.UpdateDestinationInput{
 						DeliveryStreamName:             aws.String(rName),
 						DestinationId:                  aws.String("destinationId-000000000001"),
@@ -1462,7 +1462,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamExists(n string, stream *firehose.
 			return fmt.Errorf("No Kinesis Firehose ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 		describeOpts := &firehose.DescribeDeliveryStreamInput{
 			DeliveryStreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -1665,7 +1665,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 		if rs.Type != "aws_kinesis_firehose_delivery_stream" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 		describeOpts := &firehose.DescribeDeliveryStreamInput{
 			DeliveryStreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -1684,7 +1684,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 }
 
 func testAccCheckFirehoseLambdaFunctionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
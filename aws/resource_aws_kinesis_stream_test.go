@@ -28,7 +28,7 @@ func testSweepKinesisStreams(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).kinesisconn
+	conn := client.(*AWSClient).kinesisconn()
 	input := &kinesis.ListStreamsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -450,7 +450,7 @@ func testAccCheckKinesisStreamExists(n string, stream *kinesis.StreamDescription
 			return fmt.Errorf("No Kinesis ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -491,7 +491,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 		if rs.Type != "aws_kinesis_stream" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -511,7 +511,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 
 func testAccAWSKinesisStreamRegisterStreamConsumer(stream *kinesis.StreamDescription, rStr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 
 		if _, err := conn.RegisterStreamConsumer(&kinesis.RegisterStreamConsumerInput{
 			ConsumerName: aws.String(rStr),
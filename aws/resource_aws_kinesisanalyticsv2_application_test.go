@@ -28,7 +28,7 @@ func testSweepKinesisAnalyticsV2Application(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).kinesisanalyticsv2conn
+	conn := client.(*AWSClient).kinesisanalyticsv2conn()
 	input := &kinesisanalyticsv2.ListApplicationsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -2687,7 +2687,7 @@ func TestAccAWSKinesisAnalyticsV2Application_SQLApplicationConfiguration_VPCConf
 }
 
 func testAccCheckKinesisAnalyticsV2ApplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kinesisanalyticsv2_application" {
@@ -2718,7 +2718,7 @@ func testAccCheckKinesisAnalyticsV2ApplicationExists(n string, v *kinesisanalyti
 			return fmt.Errorf("No Kinesis Analytics v2 Application ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
 
 		application, err := finder.ApplicationByName(conn, rs.Primary.Attributes["name"])
 		if err != nil {
@@ -2732,7 +2732,7 @@ func testAccCheckKinesisAnalyticsV2ApplicationExists(n string, v *kinesisanalyti
 }
 
 func testAccPreCheckAWSKinesisAnalyticsV2(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
 
 	input := &kinesisanalyticsv2.ListApplicationsInput{}
 
@@ -57,7 +57,7 @@ func resourceAwsKmsAlias() *schema.Resource {
 }
 
 func resourceAwsKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -89,7 +89,7 @@ func resourceAwsKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	var alias *kms.AliasListEntry
 	var err error
@@ -129,7 +129,7 @@ func resourceAwsKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	if d.HasChange("target_key_id") {
 		err := resourceAwsKmsAliasTargetUpdate(conn, d)
@@ -157,7 +157,7 @@ func resourceAwsKmsAliasTargetUpdate(conn *kms.KMS, d *schema.ResourceData) erro
 }
 
 func resourceAwsKmsAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	req := &kms.DeleteAliasInput{
 		AliasName: aws.String(d.Id()),
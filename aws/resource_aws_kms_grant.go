@@ -130,7 +130,7 @@ func resourceAwsKmsGrant() *schema.Resource {
 }
 
 func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 	keyId := d.Get("key_id").(string)
 
 	input := kms.CreateGrantInput{
@@ -197,7 +197,7 @@ func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	keyId, grantId, err := decodeKmsGrantId(d.Id())
 	if err != nil {
@@ -258,7 +258,7 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsGrantDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	keyId, grantId, decodeErr := decodeKmsGrantId(d.Id())
 	if decodeErr != nil {
@@ -103,7 +103,7 @@ func resourceAwsLakeFormationDataLakeSettings() *schema.Resource {
 }
 
 func resourceAwsLakeFormationDataLakeSettingsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.PutDataLakeSettingsInput{}
 
@@ -166,7 +166,7 @@ func resourceAwsLakeFormationDataLakeSettingsCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.GetDataLakeSettingsInput{}
 
@@ -201,7 +201,7 @@ func resourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsLakeFormationDataLakeSettingsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.PutDataLakeSettingsInput{
 		DataLakeSettings: &lakeformation.DataLakeSettings{
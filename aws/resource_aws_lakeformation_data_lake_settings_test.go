@@ -75,7 +75,7 @@ func testAccAWSLakeFormationDataLakeSettings_withoutCatalogId(t *testing.T) {
 }
 
 func testAccCheckAWSLakeFormationDataLakeSettingsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lakeformation_data_lake_settings" {
@@ -113,7 +113,7 @@ func testAccCheckAWSLakeFormationDataLakeSettingsExists(resourceName string) res
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn()
 
 		input := &lakeformation.GetDataLakeSettingsInput{}
 
@@ -185,7 +185,7 @@ func testAccAWSLakeFormationPermissions_tableWithColumnsAndTable(t *testing.T) {
 }
 
 func testAccCheckAWSLakeFormationPermissionsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lakeformation_permissions" {
@@ -219,7 +219,7 @@ func testAccCheckAWSLakeFormationPermissionsExists(resourceName string) resource
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn()
 
 		input := &lakeformation.ListPermissionsInput{
 			MaxResults: aws.Int64(1),
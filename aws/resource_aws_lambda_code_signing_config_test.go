@@ -193,7 +193,7 @@ func testAccCheckAwsCodeSigningConfigExists(n string, mapping *lambda.GetCodeSig
 			return fmt.Errorf("Code Signing Config ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		params := &lambda.GetCodeSigningConfigInput{
 			CodeSigningConfigArn: aws.String(rs.Primary.ID),
@@ -211,7 +211,7 @@ func testAccCheckAwsCodeSigningConfigExists(n string, mapping *lambda.GetCodeSig
 }
 
 func testAccCheckCodeSigningConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_code_signing_config" {
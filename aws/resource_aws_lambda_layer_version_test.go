@@ -26,7 +26,7 @@ func testSweepLambdaLayerVersions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	lambdaconn := client.(*AWSClient).lambdaconn
+	lambdaconn := client.(*AWSClient).lambdaconn()
 	resp, err := lambdaconn.ListLayers(&lambda.ListLayersInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
@@ -240,7 +240,7 @@ func TestAccAWSLambdaLayerVersion_licenseInfo(t *testing.T) {
 }
 
 func testAccCheckLambdaLayerVersionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_layer_version" {
@@ -289,7 +289,7 @@ func testAccCheckAwsLambdaLayerVersionExists(res, layerName string) resource.Tes
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 		_, err = conn.GetLayerVersion(&lambda.GetLayerVersionInput{
 			LayerName:     aws.String(layerName),
 			VersionNumber: aws.Int64(int64(version)),
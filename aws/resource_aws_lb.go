@@ -17,9 +17,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elbv2/waiter"
 )
 
+// lbNameConstraints are the constraints of load balancer names.
+var lbNameConstraints = naming.Constraints{
+	MaxLength:     32,
+	Charset:       `0-9A-Za-z-`,
+	DefaultPrefix: "tf-lb-",
+}
+
 func resourceAwsLb() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsLbCreate,
@@ -63,7 +71,10 @@ func resourceAwsLb() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
-				ValidateFunc:  validateElbNamePrefix,
+				ValidateFunc: validation.All(
+					lbNameConstraints.ValidateNamePrefix(),
+					validation.StringDoesNotMatch(regexp.MustCompile(`^-`), "cannot begin with a hyphen"),
+				),
 			},
 
 			"internal": {
@@ -235,7 +246,9 @@ func resourceAwsLb() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
@@ -247,17 +260,10 @@ func suppressIfLBType(t string) schema.SchemaDiffSuppressFunc {
 }
 
 func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().Elbv2Tags()
 
-	var name string
-	if v, ok := d.GetOk("name"); ok {
-		name = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = resource.PrefixedUniqueId(v.(string))
-	} else {
-		name = resource.PrefixedUniqueId("tf-lb-")
-	}
+	name := lbNameConstraints.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 	d.Set("name", name)
 
 	elbOpts := &elbv2.CreateLoadBalancerInput{
@@ -333,7 +339,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 	lbArn := d.Id()
 
 	describeLbOpts := &elbv2.DescribeLoadBalancersInput{
@@ -359,10 +365,10 @@ func resourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Elbv2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating ALB (%s) tags: %s", d.Id(), err)
@@ -508,7 +514,7 @@ func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 
 	log.Printf("[INFO] Deleting LB: %s", d.Id())
 
@@ -520,7 +526,7 @@ func resourceAwsLbDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error deleting LB: %s", err)
 	}
 
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	err := cleanupLBNetworkInterfaces(ec2conn, d.Id())
 	if err != nil {
@@ -691,8 +697,8 @@ func lbSuffixFromARN(arn *string) string {
 
 // flattenAwsLbResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
 func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBalancer) error {
-	conn := meta.(*AWSClient).elbv2conn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).elbv2conn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	d.Set("arn", lb.LoadBalancerArn)
 	d.Set("arn_suffix", lbSuffixFromARN(lb.LoadBalancerArn))
@@ -103,7 +103,7 @@ func TestAccAwsLbListenerCertificate_multiple(t *testing.T) {
 }
 
 func testAccCheckAwsLbListenerCertificateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_listener_certificate" {
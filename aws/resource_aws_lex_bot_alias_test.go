@@ -328,7 +328,7 @@ func testAccCheckAwsLexBotAliasExists(rName string, output *lexmodelbuildingserv
 		botAliasName := rs.Primary.Attributes["name"]
 
 		var err error
-		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 		output, err = conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
 			BotName: aws.String(botName),
@@ -347,7 +347,7 @@ func testAccCheckAwsLexBotAliasExists(rName string, output *lexmodelbuildingserv
 
 func testAccCheckAwsLexBotAliasDestroy(botName, botAliasName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 		_, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
 			BotName: aws.String(botName),
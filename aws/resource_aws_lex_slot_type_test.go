@@ -295,7 +295,7 @@ func testAccCheckAwsLexSlotTypeExistsWithVersion(rName, slotTypeVersion string,
 		}
 
 		var err error
-		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 		output, err = conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
 			Name:    aws.String(rs.Primary.ID),
@@ -318,7 +318,7 @@ func testAccCheckAwsLexSlotTypeExists(rName string, output *lexmodelbuildingserv
 
 func testAccCheckAwsLexSlotTypeNotExists(slotTypeName, slotTypeVersion string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
 			Name:    aws.String(slotTypeName),
@@ -336,7 +336,7 @@ func testAccCheckAwsLexSlotTypeNotExists(slotTypeName, slotTypeVersion string) r
 }
 
 func testAccCheckAwsLexSlotTypeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lex_slot_type" {
@@ -85,7 +85,9 @@ func resourceAwsMediaConvertQueue() *schema.Resource {
 					mediaconvert.QueueStatusPaused,
 				}, false),
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
@@ -127,7 +129,7 @@ func resourceAwsMediaConvertQueueRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
 	}
 
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	getOpts := &mediaconvert.GetQueueInput{
 		Name: aws.String(d.Id()),
@@ -199,8 +201,8 @@ func resourceAwsMediaConvertQueueUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.MediaconvertUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -243,7 +245,7 @@ func getAwsMediaConvertAccountClient(awsClient *AWSClient) (*mediaconvert.MediaC
 		Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
 	}
 
-	output, err := awsClient.mediaconvertconn.DescribeEndpoints(input)
+	output, err := awsClient.mediaconvertconn().DescribeEndpoints(input)
 
 	if err != nil {
 		return nil, fmt.Errorf("error describing MediaConvert Endpoints: %w", err)
@@ -255,7 +257,7 @@ func getAwsMediaConvertAccountClient(awsClient *AWSClient) (*mediaconvert.MediaC
 
 	endpointURL := aws.StringValue(output.Endpoints[0].Url)
 
-	sess, err := session.NewSession(&awsClient.mediaconvertconn.Config)
+	sess, err := session.NewSession(&awsClient.mediaconvertconn().Config)
 
 	if err != nil {
 		return nil, fmt.Errorf("error creating AWS MediaConvert session: %w", err)
@@ -67,13 +67,15 @@ func resourceAwsMediaPackageChannel() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediapackageconn
+	conn := meta.(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.CreateChannelInput{
 		Id:          aws.String(d.Get("channel_id").(string)),
@@ -95,8 +97,8 @@ func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediapackageconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).mediapackageconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &mediapackage.DescribeChannelInput{
 		Id: aws.String(d.Id()),
@@ -121,7 +123,7 @@ func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsMediaPackageChannelUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediapackageconn
+	conn := meta.(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.UpdateChannelInput{
 		Id:          aws.String(d.Id()),
@@ -134,8 +136,8 @@ func resourceAwsMediaPackageChannelUpdate(d *schema.ResourceData, meta interface
 	}
 
 	arn := d.Get("arn").(string)
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.MediapackageUpdateTags(conn, arn, o, n); err != nil {
 			return fmt.Errorf("error updating MediaPackage Channel (%s) tags: %s", arn, err)
@@ -146,7 +148,7 @@ func resourceAwsMediaPackageChannelUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsMediaPackageChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediapackageconn
+	conn := meta.(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.DeleteChannelInput{
 		Id: aws.String(d.Id()),
@@ -27,7 +27,7 @@ func resourceAwsMediaStoreContainerPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMPolicyDocument(iamPolicyTypeMediaStore),
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 		},
@@ -35,7 +35,7 @@ func resourceAwsMediaStoreContainerPolicy() *schema.Resource {
 }
 
 func resourceAwsMediaStoreContainerPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).mediastoreconn()
 
 	input := &mediastore.PutContainerPolicyInput{
 		ContainerName: aws.String(d.Get("container_name").(string)),
@@ -52,7 +52,7 @@ func resourceAwsMediaStoreContainerPolicyPut(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsMediaStoreContainerPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).mediastoreconn()
 
 	input := &mediastore.GetContainerPolicyInput{
 		ContainerName: aws.String(d.Id()),
@@ -79,7 +79,7 @@ func resourceAwsMediaStoreContainerPolicyRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsMediaStoreContainerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).mediastoreconn()
 
 	input := &mediastore.DeleteContainerPolicyInput{
 		ContainerName: aws.String(d.Id()),
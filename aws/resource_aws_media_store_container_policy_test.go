@@ -46,7 +46,7 @@ func TestAccAWSMediaStoreContainerPolicy_basic(t *testing.T) {
 }
 
 func testAccCheckAwsMediaStoreContainerPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_media_store_container_policy" {
@@ -83,7 +83,7 @@ func testAccCheckAwsMediaStoreContainerPolicyExists(name string) resource.TestCh
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+		conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 		input := &mediastore.GetContainerPolicyInput{
 			ContainerName: aws.String(rs.Primary.ID),
@@ -80,7 +80,7 @@ func TestAccAWSMediaStoreContainer_tags(t *testing.T) {
 }
 
 func testAccCheckAwsMediaStoreContainerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_media_store_container" {
@@ -113,7 +113,7 @@ func testAccCheckAwsMediaStoreContainerExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+		conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 		input := &mediastore.DescribeContainerInput{
 			ContainerName: aws.String(rs.Primary.ID),
@@ -126,7 +126,7 @@ func testAccCheckAwsMediaStoreContainerExists(name string) resource.TestCheckFun
 }
 
 func testAccPreCheckAWSMediaStore(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 	input := &mediastore.ListContainersInput{}
 
@@ -75,7 +75,7 @@ func testSweepMqBrokers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).mqconn
+	conn := client.(*AWSClient).mqconn()
 
 	resp, err := conn.ListBrokers(&mq.ListBrokersInput{
 		MaxResults: aws.Int64(100),
@@ -816,7 +816,7 @@ func TestAccAWSMqBroker_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsMqBrokerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mqconn
+	conn := testAccProvider.Meta().(*AWSClient).mqconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_broker" {
@@ -852,7 +852,7 @@ func testAccCheckAwsMqBrokerExists(name string, broker *mq.DescribeBrokerRespons
 			return fmt.Errorf("No MQ Broker is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mqconn
+		conn := testAccProvider.Meta().(*AWSClient).mqconn()
 		resp, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
 			BrokerId: aws.String(rs.Primary.ID),
 		})
@@ -869,7 +869,7 @@ func testAccCheckAwsMqBrokerExists(name string, broker *mq.DescribeBrokerRespons
 
 func testAccCheckAwsMqBrokerDisappears(broker *mq.DescribeBrokerResponse) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).mqconn
+		conn := testAccProvider.Meta().(*AWSClient).mqconn()
 		_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
 			BrokerId: broker.BrokerId,
 		})
@@ -879,7 +879,7 @@ func testAccCheckAwsMqBrokerDisappears(broker *mq.DescribeBrokerResponse) resour
 }
 
 func testAccPreCheckAWSMq(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).mqconn
+	conn := testAccProvider.Meta().(*AWSClient).mqconn()
 
 	input := &mq.ListBrokersInput{}
 
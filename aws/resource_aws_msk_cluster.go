@@ -347,7 +347,9 @@ func resourceAwsMskCluster() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"zookeeper_connect_string": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -357,7 +359,7 @@ func resourceAwsMskCluster() *schema.Resource {
 }
 
 func resourceAwsMskClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	input := &kafka.CreateClusterInput{
 		BrokerNodeGroupInfo:  expandMskClusterBrokerNodeGroupInfo(d.Get("broker_node_group_info").([]interface{})),
@@ -430,8 +432,8 @@ func waitForMskClusterCreation(conn *kafka.Kafka, arn string) error {
 }
 
 func resourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).kafkaconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	out, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
 		ClusterArn: aws.String(d.Id()),
@@ -501,7 +503,7 @@ func resourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	if d.HasChange("broker_node_group_info.0.ebs_volume_size") {
 		input := &kafka.UpdateBrokerStorageInput{
@@ -634,8 +636,8 @@ func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.KafkaUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating MSK Cluster (%s) tags: %s", d.Id(), err)
@@ -1119,7 +1121,7 @@ func flattenMskLoggingInfoBrokerLogsS3(e *kafka.S3) []map[string]interface{} {
 }
 
 func resourceAwsMskClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	log.Printf("[DEBUG] Deleting MSK cluster: %q", d.Id())
 	_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
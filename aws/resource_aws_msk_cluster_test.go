@@ -27,7 +27,7 @@ func testSweepMskClusters(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).kafkaconn
+	conn := client.(*AWSClient).kafkaconn()
 
 	out, err := conn.ListClusters(&kafka.ListClustersInput{})
 	if err != nil {
@@ -737,7 +737,7 @@ func testAccCheckMskClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 		opts := &kafka.DescribeClusterInput{
 			ClusterArn: aws.String(rs.Primary.ID),
 		}
@@ -764,7 +764,7 @@ func testAccCheckMskClusterExists(n string, cluster *kafka.ClusterInfo) resource
 			return fmt.Errorf("No Cluster arn is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 		resp, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
 			ClusterArn: aws.String(rs.Primary.ID),
 		})
@@ -799,7 +799,7 @@ func testAccCheckMskClusterRecreated(i, j *kafka.ClusterInfo) resource.TestCheck
 
 func testAccLoadMskTags(cluster *kafka.ClusterInfo, td *kafka.ListTagsForResourceOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 		tagOut, err := conn.ListTagsForResource(&kafka.ListTagsForResourceInput{
 			ResourceArn: cluster.ClusterArn,
@@ -835,7 +835,7 @@ func testAccCheckMskClusterTags(td *kafka.ListTagsForResourceOutput, key string,
 }
 
 func testAccPreCheckAWSMsk(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+	conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 	input := &kafka.ListClustersInput{}
 
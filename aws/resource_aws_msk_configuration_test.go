@@ -30,7 +30,7 @@ func testSweepMskConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).kafkaconn
+	conn := client.(*AWSClient).kafkaconn()
 	var sweeperErrs *multierror.Error
 
 	input := &kafka.ListConfigurationsInput{}
@@ -225,7 +225,7 @@ func TestAccAWSMskConfiguration_ServerProperties(t *testing.T) {
 }
 
 func testAccCheckMskConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+	conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_msk_configuration" {
@@ -265,7 +265,7 @@ func testAccCheckMskConfigurationExists(resourceName string, configuration *kafk
 			return fmt.Errorf("Resource ID not set: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 		input := &kafka.DescribeConfigurationInput{
 			Arn: aws.String(rs.Primary.ID),
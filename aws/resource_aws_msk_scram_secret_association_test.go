@@ -137,7 +137,7 @@ func testAccCheckMskScramSecretAssociationDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 		input := &kafka.ListScramSecretsInput{
 			ClusterArn: aws.String(rs.Primary.ID),
 		}
@@ -164,7 +164,7 @@ func testAccCheckMskScramSecretAssociationExists(resourceName string) resource.T
 			return fmt.Errorf("No ID is set for %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 		_, err := finder.ScramSecrets(conn, rs.Primary.ID)
 		if err != nil {
 			return err
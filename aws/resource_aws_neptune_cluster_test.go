@@ -452,7 +452,7 @@ func testAccCheckAWSNeptuneClusterDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAWSNeptuneClusterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).neptuneconn
+	conn := provider.Meta().(*AWSClient).neptuneconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_cluster" {
@@ -502,7 +502,7 @@ func testAccCheckAWSNeptuneClusterExistsWithProvider(n string, v *neptune.DBClus
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).neptuneconn
+		conn := provider.Meta().(*AWSClient).neptuneconn()
 		resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -530,7 +530,7 @@ func testAccCheckAWSNeptuneClusterSnapshot(rName string) resource.TestCheckFunc
 			}
 
 			awsClient := testAccProvider.Meta().(*AWSClient)
-			conn := awsClient.neptuneconn
+			conn := awsClient.neptuneconn()
 
 			log.Printf("[INFO] Deleting the Snapshot %s", rName)
 			_, snapDeleteErr := conn.DeleteDBClusterSnapshot(
@@ -94,7 +94,9 @@ func resourceAwsNetworkFirewallFirewallPolicy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"update_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -104,7 +106,7 @@ func resourceAwsNetworkFirewallFirewallPolicy() *schema.Resource {
 }
 
 func resourceAwsNetworkFirewallFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 	name := d.Get("name").(string)
 	input := &networkfirewall.CreateFirewallPolicyInput{
 		FirewallPolicy:     expandNetworkFirewallFirewallPolicy(d.Get("firewall_policy").([]interface{})),
@@ -135,8 +137,8 @@ func resourceAwsNetworkFirewallFirewallPolicyCreate(ctx context.Context, d *sche
 }
 
 func resourceAwsNetworkFirewallFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).networkfirewallconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	log.Printf("[DEBUG] Reading NetworkFirewall Firewall Policy %s", d.Id())
 
@@ -177,7 +179,7 @@ func resourceAwsNetworkFirewallFirewallPolicyRead(ctx context.Context, d *schema
 }
 
 func resourceAwsNetworkFirewallFirewallPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 	arn := d.Id()
 
 	log.Printf("[DEBUG] Updating NetworkFirewall Firewall Policy %s", arn)
@@ -198,8 +200,8 @@ func resourceAwsNetworkFirewallFirewallPolicyUpdate(ctx context.Context, d *sche
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.NetworkfirewallUpdateTags(conn, arn, o, n); err != nil {
 			return diag.FromErr(fmt.Errorf("error updating NetworkFirewall Firewall Policy (%s) tags: %w", arn, err))
 		}
@@ -209,7 +211,7 @@ func resourceAwsNetworkFirewallFirewallPolicyUpdate(ctx context.Context, d *sche
 }
 
 func resourceAwsNetworkFirewallFirewallPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 
 	log.Printf("[DEBUG] Deleting NetworkFirewall Firewall Policy %s", d.Id())
 
@@ -32,7 +32,7 @@ func testSweepNetworkFirewallFirewallPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).networkfirewallconn
+	conn := client.(*AWSClient).networkfirewallconn()
 	ctx := context.Background()
 	input := &networkfirewall.ListFirewallPoliciesInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -606,7 +606,7 @@ func testAccCheckAwsNetworkFirewallFirewallPolicyDestroy(s *terraform.State) err
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.FirewallPolicy(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -633,7 +633,7 @@ func testAccCheckAwsNetworkFirewallFirewallPolicyExists(n string) resource.TestC
 			return fmt.Errorf("No NetworkFirewall Firewall Policy ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.FirewallPolicy(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
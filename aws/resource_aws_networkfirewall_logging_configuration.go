@@ -72,7 +72,7 @@ func resourceAwsNetworkFirewallLoggingConfiguration() *schema.Resource {
 }
 
 func resourceAwsNetworkFirewallLoggingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 	firewallArn := d.Get("firewall_arn").(string)
 
 	log.Printf("[DEBUG] Adding Logging Configuration to NetworkFirewall Firewall: %s", firewallArn)
@@ -90,7 +90,7 @@ func resourceAwsNetworkFirewallLoggingConfigurationCreate(ctx context.Context, d
 }
 
 func resourceAwsNetworkFirewallLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 
 	log.Printf("[DEBUG] Reading Logging Configuration for NetworkFirewall Firewall: %s", d.Id())
 
@@ -119,7 +119,7 @@ func resourceAwsNetworkFirewallLoggingConfigurationRead(ctx context.Context, d *
 }
 
 func resourceAwsNetworkFirewallLoggingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 
 	log.Printf("[DEBUG] Updating Logging Configuration for NetworkFirewall Firewall: %s", d.Id())
 
@@ -148,7 +148,7 @@ func resourceAwsNetworkFirewallLoggingConfigurationUpdate(ctx context.Context, d
 }
 
 func resourceAwsNetworkFirewallLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 
 	log.Printf("[DEBUG] Deleting Logging Configuration for NetworkFirewall Firewall: %s", d.Id())
 
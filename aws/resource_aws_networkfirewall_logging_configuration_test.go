@@ -29,7 +29,7 @@ func testSweepNetworkFirewallLoggingConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).networkfirewallconn
+	conn := client.(*AWSClient).networkfirewallconn()
 	ctx := context.TODO()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -650,7 +650,7 @@ func testAccCheckAwsNetworkFirewallLoggingConfigurationDestroy(s *terraform.Stat
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.LoggingConfiguration(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -677,7 +677,7 @@ func testAccCheckAwsNetworkFirewallLoggingConfigurationExists(n string) resource
 			return fmt.Errorf("No NetworkFirewall Logging Configuration ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.LoggingConfiguration(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
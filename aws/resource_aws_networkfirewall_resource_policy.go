@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkfirewall/finder"
 )
 
@@ -29,8 +28,8 @@ func resourceAwsNetworkFirewallResourcePolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ValidateFunc:     validateResourcePolicyDocument(iamPolicyTypeResource),
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"resource_arn": {
 				Type:         schema.TypeString,
@@ -43,7 +42,7 @@ func resourceAwsNetworkFirewallResourcePolicy() *schema.Resource {
 }
 
 func resourceAwsNetworkFirewallResourcePolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 	resourceArn := d.Get("resource_arn").(string)
 	input := &networkfirewall.PutResourcePolicyInput{
 		ResourceArn: aws.String(resourceArn),
@@ -63,7 +62,7 @@ func resourceAwsNetworkFirewallResourcePolicyPut(ctx context.Context, d *schema.
 }
 
 func resourceAwsNetworkFirewallResourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 	resourceArn := d.Id()
 
 	log.Printf("[DEBUG] Reading NetworkFirewall Resource Policy for resource: %s", resourceArn)
@@ -89,7 +88,7 @@ func resourceAwsNetworkFirewallResourcePolicyRead(ctx context.Context, d *schema
 }
 
 func resourceAwsNetworkFirewallResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).networkfirewallconn
+	conn := meta.(*AWSClient).networkfirewallconn()
 
 	log.Printf("[DEBUG] Deleting NetworkFirewall Resource Policy for resource: %s", d.Id())
 
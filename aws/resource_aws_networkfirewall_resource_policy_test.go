@@ -151,7 +151,7 @@ func testAccCheckAwsNetworkFirewallResourcePolicyDestroy(s *terraform.State) err
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		policy, err := finder.ResourcePolicy(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -178,7 +178,7 @@ func testAccCheckAwsNetworkFirewallResourcePolicyExists(n string) resource.TestC
 			return fmt.Errorf("No NetworkFirewall Resource Policy ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		policy, err := finder.ResourcePolicy(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
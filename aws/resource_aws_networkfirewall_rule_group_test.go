@@ -32,7 +32,7 @@ func testSweepNetworkFirewallRuleGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).networkfirewallconn
+	conn := client.(*AWSClient).networkfirewallconn()
 	ctx := context.Background()
 	input := &networkfirewall.ListRuleGroupsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -766,7 +766,7 @@ func testAccCheckAwsNetworkFirewallRuleGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.RuleGroup(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -793,7 +793,7 @@ func testAccCheckAwsNetworkFirewallRuleGroupExists(n string) resource.TestCheckF
 			return fmt.Errorf("No NetworkFirewall Rule Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.RuleGroup(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
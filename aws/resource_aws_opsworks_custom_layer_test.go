@@ -180,7 +180,7 @@ func testAccCheckAWSOpsworksLayerExists(n string, opslayer *opsworks.Layer) reso
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 
 		params := &opsworks.DescribeLayersInput{
 			LayerIds: []*string{aws.String(rs.Primary.ID)},
@@ -263,7 +263,7 @@ func testAccCheckAWSOpsworksCreateLayerAttributes(
 }
 
 func testAccCheckAwsOpsworksLayerDestroy(resourceType string, s *terraform.State) error {
-	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resourceType {
 			continue
@@ -60,7 +60,7 @@ func resourceAwsOpsworksPermissionDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsOpsworksPermissionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DescribePermissionsInput{
 		IamUserArn: aws.String(d.Get("user_arn").(string)),
@@ -107,7 +107,7 @@ func resourceAwsOpsworksPermissionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsOpsworksSetPermission(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.SetPermissionInput{
 		AllowSudo:  aws.Bool(d.Get("allow_sudo").(bool)),
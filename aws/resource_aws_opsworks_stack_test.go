@@ -256,7 +256,7 @@ func testAccCheckAWSOpsworksStackRecreated(t *testing.T, before, after *opsworks
 }
 
 func testAccPreCheckAWSOpsWorksStacks(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	conn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 
 	input := &opsworks.DescribeStacksInput{}
 
@@ -474,7 +474,7 @@ func testAccCheckAWSOpsworksStackExists(
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 
 		params := &opsworks.DescribeStacksInput{
 			StackIds: []*string{aws.String(rs.Primary.ID)},
@@ -505,7 +505,7 @@ func testAccCheckAWSOpsworksStackExists(
 }
 
 func testAccCheckAwsOpsworksStackDestroy(s *terraform.State) error {
-	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_stack" {
 			continue
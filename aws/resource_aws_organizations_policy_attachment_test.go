@@ -109,7 +109,7 @@ func testAccAwsOrganizationsPolicyAttachment_Root(t *testing.T) {
 }
 
 func testAccCheckAwsOrganizationsPolicyAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_organizations_policy_attachment" {
@@ -168,7 +168,7 @@ func testAccCheckAwsOrganizationsPolicyAttachmentExists(resourceName string) res
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 
 		targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(rs.Primary.ID)
 		if err != nil {
@@ -166,7 +166,7 @@ func testAccCheckAWSPinpointAPNSSandboxChannelExists(n string, channel *pinpoint
 			return fmt.Errorf("No Pinpoint APNs Channel with that Application ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetApnsSandboxChannelInput{
@@ -217,7 +217,7 @@ resource "aws_pinpoint_apns_sandbox_channel" "test_channel" {
 }
 
 func testAccCheckAWSPinpointAPNSSandboxChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_apns_sandbox_channel" {
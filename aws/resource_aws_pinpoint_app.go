@@ -137,13 +137,15 @@ func resourceAwsPinpointApp() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsPinpointAppCreate(d *schema.ResourceData, meta interface{}) error {
-	pinpointconn := meta.(*AWSClient).pinpointconn
+	pinpointconn := meta.(*AWSClient).pinpointconn()
 
 	var name string
 
@@ -179,7 +181,7 @@ func resourceAwsPinpointAppCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	appSettings := &pinpoint.WriteApplicationSettingsRequest{}
 
@@ -211,8 +213,8 @@ func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if !d.IsNewResource() {
 		arn := d.Get("arn").(string)
-		if d.HasChange("tags") {
-			o, n := d.GetChange("tags")
+		if d.HasChange("tags_all") {
+			o, n := d.GetChange("tags_all")
 
 			if err := keyvaluetags.PinpointUpdateTags(conn, arn, o, n); err != nil {
 				return fmt.Errorf("error updating PinPoint Application (%s) tags: %s", arn, err)
@@ -224,8 +226,8 @@ func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).pinpointconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	log.Printf("[INFO] Reading Pinpoint App Attributes for %s", d.Id())
 
@@ -284,7 +286,7 @@ func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsPinpointAppDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Pinpoint Delete App: %s", d.Id())
 	_, err := conn.DeleteApp(&pinpoint.DeleteAppInput{
@@ -24,7 +24,7 @@ func testSweepPinpointApps(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).pinpointconn
+	conn := client.(*AWSClient).pinpointconn()
 
 	input := &pinpoint.GetAppsInput{}
 
@@ -218,7 +218,7 @@ func TestAccAWSPinpointApp_Tags(t *testing.T) {
 }
 
 func testAccPreCheckAWSPinpointApp(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	input := &pinpoint.GetAppsInput{}
 
@@ -244,7 +244,7 @@ func testAccCheckAWSPinpointAppExists(n string, application *pinpoint.Applicatio
 			return fmt.Errorf("No Pinpoint app with that ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetAppInput{
@@ -378,7 +378,7 @@ resource "aws_pinpoint_app" "test" {
 }
 
 func testAccCheckAWSPinpointAppDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_app" {
@@ -51,7 +51,7 @@ func resourceAwsPinpointSMSChannel() *schema.Resource {
 }
 
 func resourceAwsPinpointSMSChannelUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -83,7 +83,7 @@ func resourceAwsPinpointSMSChannelUpsert(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsPinpointSMSChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[INFO] Reading Pinpoint SMS Channel  for application %s", d.Id())
 
@@ -110,7 +110,7 @@ func resourceAwsPinpointSMSChannelRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsPinpointSMSChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Deleting Pinpoint SMS Channel for application %s", d.Id())
 	_, err := conn.DeleteSmsChannel(&pinpoint.DeleteSmsChannelInput{
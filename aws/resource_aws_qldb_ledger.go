@@ -47,13 +47,15 @@ func resourceAwsQLDBLedger() *schema.Resource {
 				Default:  true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsQLDBLedgerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
+	conn := meta.(*AWSClient).qldbconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -104,8 +106,8 @@ func resourceAwsQLDBLedgerCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsQLDBLedgerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).qldbconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	// Refresh the QLDB state
 	input := &qldb.DescribeLedgerInput{
@@ -153,7 +155,7 @@ func resourceAwsQLDBLedgerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsQLDBLedgerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
+	conn := meta.(*AWSClient).qldbconn()
 
 	if d.HasChange("deletion_protection") {
 		val := d.Get("deletion_protection").(bool)
@@ -170,8 +172,8 @@ func resourceAwsQLDBLedgerUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.QldbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -181,7 +183,7 @@ func resourceAwsQLDBLedgerUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsQLDBLedgerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
+	conn := meta.(*AWSClient).qldbconn()
 	deleteLedgerOpts := &qldb.DeleteLedgerInput{
 		Name: aws.String(d.Id()),
 	}
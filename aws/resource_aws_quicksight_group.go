@@ -60,7 +60,7 @@ func resourceAwsQuickSightGroup() *schema.Resource {
 }
 
 func resourceAwsQuickSightGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID := meta.(*AWSClient).accountid
 	namespace := d.Get("namespace").(string)
@@ -90,7 +90,7 @@ func resourceAwsQuickSightGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsQuickSightGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID, namespace, groupName, err := resourceAwsQuickSightGroupParseID(d.Id())
 	if err != nil {
@@ -123,7 +123,7 @@ func resourceAwsQuickSightGroupRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsQuickSightGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID, namespace, groupName, err := resourceAwsQuickSightGroupParseID(d.Id())
 	if err != nil {
@@ -154,7 +154,7 @@ func resourceAwsQuickSightGroupUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsQuickSightGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID, namespace, groupName, err := resourceAwsQuickSightGroupParseID(d.Id())
 	if err != nil {
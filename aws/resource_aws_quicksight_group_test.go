@@ -116,7 +116,7 @@ func testAccCheckQuickSightGroupExists(resourceName string, group *quicksight.Gr
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn()
 
 		input := &quicksight.DescribeGroupInput{
 			AwsAccountId: aws.String(awsAccountID),
@@ -141,7 +141,7 @@ func testAccCheckQuickSightGroupExists(resourceName string, group *quicksight.Gr
 }
 
 func testAccCheckQuickSightGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_quicksight_group" {
 			continue
@@ -173,7 +173,7 @@ func testAccCheckQuickSightGroupDestroy(s *terraform.State) error {
 
 func testAccCheckQuickSightGroupDisappears(v *quicksight.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn()
 
 		arn, err := arn.Parse(aws.StringValue(v.Arn))
 		if err != nil {
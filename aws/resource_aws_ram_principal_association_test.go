@@ -60,7 +60,7 @@ func TestAccAwsRamPrincipalAssociation_disappears(t *testing.T) {
 
 func testAccCheckAwsRamPrincipalAssociationDisappears(resourceShareAssociation *ram.ResourceShareAssociation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 		input := &ram.DisassociateResourceShareInput{
 			Principals:       []*string{resourceShareAssociation.AssociatedEntity},
@@ -78,7 +78,7 @@ func testAccCheckAwsRamPrincipalAssociationDisappears(resourceShareAssociation *
 
 func testAccCheckAwsRamPrincipalAssociationExists(resourceName string, resourceShare *ram.ResourceShareAssociation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -116,7 +116,7 @@ func testAccCheckAwsRamPrincipalAssociationExists(resourceName string, resourceS
 }
 
 func testAccCheckAwsRamPrincipalAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ramconn
+	conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ram_principal_association" {
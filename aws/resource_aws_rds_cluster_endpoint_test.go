@@ -137,7 +137,7 @@ func testAccCheckAWSClusterEndpointDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAWSClusterEndpointDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).rdsconn
+	conn := provider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rds_cluster_endpoint" {
@@ -185,7 +185,7 @@ func testAccCheckAWSRDSClusterEndpointExistsWithProvider(resourceName string, en
 			return fmt.Errorf("DBClusterEndpoint ID is not set")
 		}
 
-		conn := provider.Meta().(*AWSClient).rdsconn
+		conn := provider.Meta().(*AWSClient).rdsconn()
 
 		response, err := conn.DescribeDBClusterEndpoints(&rds.DescribeDBClusterEndpointsInput{
 			DBClusterEndpointIdentifier: aws.String(rs.Primary.ID),
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsResourceTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsResourceTagsCreate,
+		Read:   resourceAwsResourceTagsRead,
+		Update: resourceAwsResourceTagsUpdate,
+		Delete: resourceAwsResourceTagsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags": {
+				Type:         schema.TypeMap,
+				Required:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateTags,
+			},
+		},
+	}
+}
+
+func resourceAwsResourceTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn()
+
+	resourceARN := d.Get("resource_arn").(string)
+
+	if err := keyvaluetags.ResourcegroupstaggingapiUpdateTags(conn, resourceARN, nil, d.Get("tags").(map[string]interface{})); err != nil {
+		return fmt.Errorf("error creating tags for resource (%s): %w", resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+
+	return resourceAwsResourceTagsRead(d, meta)
+}
+
+func resourceAwsResourceTagsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn()
+
+	tags, err := keyvaluetags.ResourcegroupstaggingapiListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading tags for resource (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws()
+
+	// Only the configured tags are managed, except when importing.
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		tags = tags.Only(keyvaluetags.New(v))
+	}
+
+	if len(tags) == 0 {
+		log.Printf("[WARN] Tags for resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_arn", d.Id())
+
+	// Tags of other resources are not filtered by the provider ignore_tags configuration.
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsResourceTagsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn()
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.ResourcegroupstaggingapiUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags for resource (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsResourceTagsRead(d, meta)
+}
+
+func resourceAwsResourceTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn()
+
+	if err := keyvaluetags.ResourcegroupstaggingapiUpdateTags(conn, d.Id(), d.Get("tags").(map[string]interface{}), nil); err != nil {
+		return fmt.Errorf("error deleting tags for resource (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func TestAccAWSResourceTags_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resource_tags.test"
+	queueResourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceTagsConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", queueResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSResourceTags_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceTagsConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsResourceTags(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSResourceTags_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceTagsConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSResourceTagsConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSResourceTagsConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSResourceTagsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).resourcegroupstaggingapiconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resource_tags" {
+			continue
+		}
+
+		tags, err := keyvaluetags.ResourcegroupstaggingapiListTags(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for k := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "tags.") || k == "tags.%" {
+				continue
+			}
+
+			if key := strings.TrimPrefix(k, "tags."); tags.KeyExists(key) {
+				return fmt.Errorf("Tag (%s) for resource (%s) still exists", key, rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSResourceTagsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupstaggingapiconn()
+
+		tags, err := keyvaluetags.ResourcegroupstaggingapiListTags(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(tags.IgnoreAws()) == 0 {
+			return fmt.Errorf("Tags for resource (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSResourceTagsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  # Tags are managed by the aws_resource_tags resource.
+  ignore_tags {
+    key_prefixes = ["key"]
+  }
+}
+`, rName)
+}
+
+func testAccAWSResourceTagsConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSResourceTagsConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sqs_queue.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSResourceTagsConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSResourceTagsConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sqs_queue.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
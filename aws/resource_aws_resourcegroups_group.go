@@ -62,7 +62,9 @@ func resourceAwsResourceGroupsGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
@@ -77,7 +79,7 @@ func extractResourceGroupResourceQuery(resourceQueryList []interface{}) *resourc
 }
 
 func resourceAwsResourceGroupsGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupsconn
+	conn := meta.(*AWSClient).resourcegroupsconn()
 
 	input := resourcegroups.CreateGroupInput{
 		Description:   aws.String(d.Get("description").(string)),
@@ -97,8 +99,8 @@ func resourceAwsResourceGroupsGroupCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsResourceGroupsGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupsconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).resourcegroupsconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	g, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 		GroupName: aws.String(d.Id()),
@@ -146,7 +148,7 @@ func resourceAwsResourceGroupsGroupRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsResourceGroupsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupsconn
+	conn := meta.(*AWSClient).resourcegroupsconn()
 
 	if d.HasChange("description") {
 		input := resourcegroups.UpdateGroupInput{
@@ -172,8 +174,8 @@ func resourceAwsResourceGroupsGroupUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.ResourcegroupsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -183,7 +185,7 @@ func resourceAwsResourceGroupsGroupUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsResourceGroupsGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupsconn
+	conn := meta.(*AWSClient).resourcegroupsconn()
 
 	input := resourcegroups.DeleteGroupInput{
 		GroupName: aws.String(d.Id()),
@@ -358,7 +358,7 @@ func TestAccAWSRoute53HealthCheck_disappears(t *testing.T) {
 }
 
 func testAccCheckRoute53HealthCheckDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).r53conn
+	conn := testAccProvider.Meta().(*AWSClient).r53conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_health_check" {
@@ -387,7 +387,7 @@ func testAccCheckRoute53HealthCheckDestroy(s *terraform.State) error {
 
 func testAccCheckRoute53HealthCheckExists(n string, hCheck *route53.HealthCheck) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).r53conn()
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -420,7 +420,7 @@ func testAccCheckRoute53HealthCheckExists(n string, hCheck *route53.HealthCheck)
 
 func testAccCheckRoute53HealthCheckDisappears(hCheck *route53.HealthCheck) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).r53conn()
 		input := &route53.DeleteHealthCheckInput{
 			HealthCheckId: hCheck.Id,
 		}
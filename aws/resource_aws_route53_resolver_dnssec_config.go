@@ -52,7 +52,7 @@ func resourceAwsRoute53ResolverDnssecConfig() *schema.Resource {
 }
 
 func resourceAwsRoute53ResolverDnssecConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	req := &route53resolver.UpdateResolverDnssecConfigInput{
 		ResourceId: aws.String(d.Get("resource_id").(string)),
@@ -76,7 +76,7 @@ func resourceAwsRoute53ResolverDnssecConfigCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsRoute53ResolverDnssecConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	config, err := finder.ResolverDnssecConfigByID(conn, d.Id())
 
@@ -108,7 +108,7 @@ func resourceAwsRoute53ResolverDnssecConfigRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsRoute53ResolverDnssecConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	log.Printf("[DEBUG] Deleting Route53 Resolver DNSSEC config: %s", d.Id())
 	_, err := conn.UpdateResolverDnssecConfig(&route53resolver.UpdateResolverDnssecConfigInput{
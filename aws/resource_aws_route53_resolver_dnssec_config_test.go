@@ -27,7 +27,7 @@ func testSweepRoute53ResolverDnssecConfig(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).route53resolverconn
+	conn := client.(*AWSClient).route53resolverconn()
 
 	var errors error
 	err = conn.ListResolverDnssecConfigsPages(&route53resolver.ListResolverDnssecConfigsInput{}, func(page *route53resolver.ListResolverDnssecConfigsOutput, isLast bool) bool {
@@ -128,7 +128,7 @@ func TestAccAWSRoute53ResolverDnssecConfig_disappear(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverDnssecConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_dnssec_config" {
@@ -178,7 +178,7 @@ func testAccCheckRoute53ResolverDnssecConfigExists(n string, config *route53reso
 			return fmt.Errorf("No Route 53 Resolver Dnssec config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 		input := &route53resolver.ListResolverDnssecConfigsInput{}
 
 		err := conn.ListResolverDnssecConfigsPages(input, func(page *route53resolver.ListResolverDnssecConfigsOutput, lastPage bool) bool {
@@ -29,7 +29,7 @@ func testSweepRoute53ResolverQueryLogConfigs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).route53resolverconn
+	conn := client.(*AWSClient).route53resolverconn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListResolverQueryLogConfigsPages(&route53resolver.ListResolverQueryLogConfigsInput{}, func(page *route53resolver.ListResolverQueryLogConfigsOutput, isLast bool) bool {
@@ -180,7 +180,7 @@ func TestAccAWSRoute53ResolverQueryLogConfig_tags(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverQueryLogConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_query_log_config" {
@@ -213,7 +213,7 @@ func testAccCheckRoute53ResolverQueryLogConfigExists(n string, v *route53resolve
 			return fmt.Errorf("No Route 53 Resolver Query Log Config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 		out, err := finder.ResolverQueryLogConfigByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -63,6 +63,7 @@ func resourceAwsS3AccessPoint() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				ValidateFunc:     validateResourcePolicyDocument(iamPolicyTypeResource),
 			},
 			"public_access_block_configuration": {
 				Type:             schema.TypeList,
@@ -120,7 +121,7 @@ func resourceAwsS3AccessPoint() *schema.Resource {
 }
 
 func resourceAwsS3AccessPointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountId := meta.(*AWSClient).accountid
 	if v, ok := d.GetOk("account_id"); ok {
@@ -173,7 +174,7 @@ func resourceAwsS3AccessPointCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsS3AccessPointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountId, name, err := s3AccessPointParseId(d.Id())
 	if err != nil {
@@ -282,7 +283,7 @@ func resourceAwsS3AccessPointRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsS3AccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountId, name, err := s3AccessPointParseId(d.Id())
 	if err != nil {
@@ -318,7 +319,7 @@ func resourceAwsS3AccessPointUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsS3AccessPointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountId, name, err := s3AccessPointParseId(d.Id())
 	if err != nil {
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
 func init() {
@@ -29,7 +28,7 @@ func testSweepS3AccessPoints(region string) error {
 	}
 
 	accountId := client.(*AWSClient).accountid
-	conn := client.(*AWSClient).s3controlconn
+	conn := client.(*AWSClient).s3controlconn()
 
 	input := &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountId),
@@ -392,7 +391,7 @@ func testAccCheckAWSS3AccessPointDisappears(n string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		_, err = conn.DeleteAccessPoint(&s3control.DeleteAccessPointInput{
 			AccountId: aws.String(accountId),
@@ -407,7 +406,7 @@ func testAccCheckAWSS3AccessPointDisappears(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSS3AccessPointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_access_point" {
@@ -446,7 +445,7 @@ func testAccCheckAWSS3AccessPointExists(n string, output *s3control.GetAccessPoi
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		resp, err := conn.GetAccessPoint(&s3control.GetAccessPointInput{
 			AccountId: aws.String(accountId),
@@ -478,7 +477,7 @@ func testAccCheckAWSS3AccessPointHasPolicy(n string, fn func() string) resource.
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		resp, err := conn.GetAccessPointPolicy(&s3control.GetAccessPointPolicyInput{
 			AccountId: aws.String(accountId),
@@ -491,7 +490,7 @@ func testAccCheckAWSS3AccessPointHasPolicy(n string, fn func() string) resource.
 		actualPolicyText := *resp.Policy
 		expectedPolicyText := fn()
 
-		equivalent, err := awspolicy.PoliciesAreEquivalent(actualPolicyText, expectedPolicyText)
+		equivalent, err := iamPoliciesAreEquivalent(actualPolicyText, expectedPolicyText)
 		if err != nil {
 			return fmt.Errorf("Error testing policy equivalence: %s", err)
 		}
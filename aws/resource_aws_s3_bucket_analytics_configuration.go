@@ -126,7 +126,7 @@ func resourceAwsS3BucketAnalyticsConfiguration() *schema.Resource {
 var filterAtLeastOneOfKeys = []string{"filter.0.prefix", "filter.0.tags"}
 
 func resourceAwsS3BucketAnalyticsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
@@ -168,7 +168,7 @@ func resourceAwsS3BucketAnalyticsConfigurationPut(d *schema.ResourceData, meta i
 }
 
 func resourceAwsS3BucketAnalyticsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
 	if err != nil {
@@ -206,7 +206,7 @@ func resourceAwsS3BucketAnalyticsConfigurationRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsS3BucketAnalyticsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
 	if err != nil {
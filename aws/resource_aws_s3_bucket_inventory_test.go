@@ -129,7 +129,7 @@ func testAccCheckAWSS3BucketInventoryConfigExists(n string, res *s3.InventoryCon
 			return fmt.Errorf("No S3 bucket inventory configuration ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
 		bucket, name, err := resourceAwsS3BucketInventoryParseID(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -152,7 +152,7 @@ func testAccCheckAWSS3BucketInventoryConfigExists(n string, res *s3.InventoryCon
 }
 
 func testAccCheckAWSS3BucketInventoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3conn
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_inventory" {
@@ -59,7 +59,7 @@ func resourceAwsS3BucketMetric() *schema.Resource {
 }
 
 func resourceAwsS3BucketMetricPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
 
@@ -104,7 +104,7 @@ func resourceAwsS3BucketMetricPut(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsS3BucketMetricDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketMetricParseID(d.Id())
 	if err != nil {
@@ -129,7 +129,7 @@ func resourceAwsS3BucketMetricDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketMetricRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketMetricParseID(d.Id())
 	if err != nil {
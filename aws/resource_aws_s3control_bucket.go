@@ -64,13 +64,15 @@ func resourceAwsS3ControlBucket() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsS3ControlBucketCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -101,8 +103,8 @@ func resourceAwsS3ControlBucketCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsS3ControlBucketRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).s3controlconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	parsedArn, err := arn.Parse(d.Id())
 
@@ -168,10 +170,10 @@ func resourceAwsS3ControlBucketRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsS3ControlBucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.S3controlBucketUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating S3 Control Bucket (%s) tags: %w", d.Id(), err)
@@ -182,7 +184,7 @@ func resourceAwsS3ControlBucketUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsS3ControlBucketDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
@@ -116,7 +116,7 @@ func resourceAwsS3ControlBucketLifecycleConfiguration() *schema.Resource {
 }
 
 func resourceAwsS3ControlBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -150,7 +150,7 @@ func resourceAwsS3ControlBucketLifecycleConfigurationCreate(d *schema.ResourceDa
 }
 
 func resourceAwsS3ControlBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
@@ -205,7 +205,7 @@ func resourceAwsS3ControlBucketLifecycleConfigurationRead(d *schema.ResourceData
 }
 
 func resourceAwsS3ControlBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
@@ -235,7 +235,7 @@ func resourceAwsS3ControlBucketLifecycleConfigurationUpdate(d *schema.ResourceDa
 }
 
 func resourceAwsS3ControlBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
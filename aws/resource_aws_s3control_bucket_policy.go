@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAwsS3ControlBucketPolicy() *schema.Resource {
@@ -33,7 +32,7 @@ func resourceAwsS3ControlBucketPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validation.StringIsJSON,
+				ValidateFunc:     validateResourcePolicyDocument(iamPolicyTypeResource),
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 		},
@@ -41,7 +40,7 @@ func resourceAwsS3ControlBucketPolicy() *schema.Resource {
 }
 
 func resourceAwsS3ControlBucketPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -62,7 +61,7 @@ func resourceAwsS3ControlBucketPolicyCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsS3ControlBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
@@ -114,7 +113,7 @@ func resourceAwsS3ControlBucketPolicyRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsS3ControlBucketPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	input := &s3control.PutBucketPolicyInput{
 		Bucket: aws.String(d.Id()),
@@ -131,7 +130,7 @@ func resourceAwsS3ControlBucketPolicyUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsS3ControlBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
@@ -25,7 +25,7 @@ func testSweepSagemakerCodeRepositories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 
 	err = conn.ListCodeRepositoriesPages(&sagemaker.ListCodeRepositoriesInput{}, func(page *sagemaker.ListCodeRepositoriesOutput, lastPage bool) bool {
 		for _, instance := range page.CodeRepositorySummaryList {
@@ -180,7 +180,7 @@ func TestAccAWSSagemakerCodeRepository_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerCodeRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_code_repository" {
@@ -211,7 +211,7 @@ func testAccCheckAWSSagemakerCodeRepositoryExists(n string, codeRepo *sagemaker.
 			return fmt.Errorf("No sagmaker Code Repository ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.CodeRepositoryByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -224,7 +224,9 @@ func resourceAwsSagemakerDomain() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -242,7 +244,7 @@ func resourceAwsSagemakerDomain() *schema.Resource {
 }
 
 func resourceAwsSagemakerDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	input := &sagemaker.CreateDomainInput{
 		DomainName:           aws.String(d.Get("domain_name").(string)),
@@ -283,8 +285,8 @@ func resourceAwsSagemakerDomainCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSagemakerDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).sagemakerconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	domain, err := finder.DomainByName(conn, d.Id())
 	if err != nil {
@@ -329,7 +331,7 @@ func resourceAwsSagemakerDomainRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSagemakerDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	if d.HasChange("default_user_settings") {
 		input := &sagemaker.UpdateDomainInput{
@@ -344,8 +346,8 @@ func resourceAwsSagemakerDomainUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating SageMaker domain (%s) tags: %w", d.Id(), err)
@@ -356,7 +358,7 @@ func resourceAwsSagemakerDomainUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSagemakerDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	input := &sagemaker.DeleteDomainInput{
 		DomainId: aws.String(d.Id()),
@@ -30,7 +30,7 @@ func testSweepSagemakerDomains(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 
 	err = conn.ListDomainsPages(&sagemaker.ListDomainsInput{}, func(page *sagemaker.ListDomainsOutput, lastPage bool) bool {
 		for _, instance := range page.Domains {
@@ -376,7 +376,7 @@ func TestAccAWSSagemakerDomain_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerDomainDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_domain" {
@@ -413,7 +413,7 @@ func testAccCheckAWSSagemakerDomainExists(n string, codeRepo *sagemaker.Describe
 			return fmt.Errorf("No sagmaker domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.DomainByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -41,13 +41,15 @@ func resourceAwsSagemakerEndpoint() *schema.Resource {
 				ValidateFunc: validateSagemakerName,
 			},
 
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -85,8 +87,8 @@ func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).sagemakerconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	describeInput := &sagemaker.DescribeEndpointInput{
 		EndpointName: aws.String(d.Id()),
@@ -131,10 +133,10 @@ func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating Sagemaker Endpoint (%s) tags: %s", d.Id(), err)
@@ -166,7 +168,7 @@ func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSagemakerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	deleteEndpointOpts := &sagemaker.DeleteEndpointInput{
 		EndpointName: aws.String(d.Id()),
@@ -94,7 +94,10 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 				ValidateFunc: validateArn,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"ignore_tags": ignoreTagsSchema(),
 
 			"data_capture_config": {
 				Type:     schema.TypeList,
@@ -196,7 +199,7 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 }
 
 func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -233,8 +236,8 @@ func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, met
 }
 
 func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).sagemakerconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	request := &sagemaker.DescribeEndpointConfigInput{
 		EndpointConfigName: aws.String(d.Id()),
@@ -279,10 +282,10 @@ func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsSagemakerEndpointConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating Sagemaker Endpoint Configuration (%s) tags: %s", d.Id(), err)
@@ -292,7 +295,7 @@ func resourceAwsSagemakerEndpointConfigurationUpdate(d *schema.ResourceData, met
 }
 
 func resourceAwsSagemakerEndpointConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	deleteOpts := &sagemaker.DeleteEndpointConfigInput{
 		EndpointConfigName: aws.String(d.Id()),
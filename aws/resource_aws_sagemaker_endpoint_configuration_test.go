@@ -27,7 +27,7 @@ func testSweepSagemakerEndpointConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 
 	req := &sagemaker.ListEndpointConfigsInput{
 		NameContains: aws.String("tf-acc-test"),
@@ -261,7 +261,7 @@ func TestAccAWSSagemakerEndpointConfiguration_disappears(t *testing.T) {
 }
 
 func testAccCheckSagemakerEndpointConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_endpoint_configuration" {
@@ -299,7 +299,7 @@ func testAccCheckSagemakerEndpointConfigurationExists(n string) resource.TestChe
 			return fmt.Errorf("no SageMaker endpoint config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		opts := &sagemaker.DescribeEndpointConfigInput{
 			EndpointConfigName: aws.String(rs.Primary.ID),
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/waiter"
@@ -44,7 +45,7 @@ func resourceAwsSagemakerImage() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: tfarn.IAMRole.ValidateFunc(),
 			},
 			"display_name": {
 				Type:         schema.TypeString,
@@ -56,13 +57,15 @@ func resourceAwsSagemakerImage() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 512),
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsSagemakerImageCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	name := d.Get("image_name").(string)
 	input := &sagemaker.CreateImageInput{
@@ -99,8 +102,8 @@ func resourceAwsSagemakerImageCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSagemakerImageRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).sagemakerconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	image, err := finder.ImageByName(conn, d.Id())
 	if err != nil {
@@ -134,7 +137,7 @@ func resourceAwsSagemakerImageRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsSagemakerImageUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 	needsUpdate := false
 
 	input := &sagemaker.UpdateImageInput{
@@ -175,8 +178,8 @@ func resourceAwsSagemakerImageUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating SageMaker Image (%s) tags: %s", d.Id(), err)
@@ -187,7 +190,7 @@ func resourceAwsSagemakerImageUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSagemakerImageDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	input := &sagemaker.DeleteImageInput{
 		ImageName: aws.String(d.Id()),
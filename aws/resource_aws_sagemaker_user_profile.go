@@ -208,7 +208,9 @@ func resourceAwsSagemakerUserProfile() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"home_efs_file_system_uid": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -218,7 +220,7 @@ func resourceAwsSagemakerUserProfile() *schema.Resource {
 }
 
 func resourceAwsSagemakerUserProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	input := &sagemaker.CreateUserProfileInput{
 		UserProfileName: aws.String(d.Get("user_profile_name").(string)),
@@ -263,8 +265,8 @@ func resourceAwsSagemakerUserProfileCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSagemakerUserProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).sagemakerconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	domainID, userProfileName, err := decodeSagemakerUserProfileName(d.Id())
 	if err != nil {
@@ -307,7 +309,7 @@ func resourceAwsSagemakerUserProfileRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSagemakerUserProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	if d.HasChange("user_settings") {
 		input := &sagemaker.UpdateUserProfileInput{
@@ -323,8 +325,8 @@ func resourceAwsSagemakerUserProfileUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating SageMaker UserProfile (%s) tags: %w", d.Id(), err)
@@ -335,7 +337,7 @@ func resourceAwsSagemakerUserProfileUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSagemakerUserProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	userProfileName := d.Get("user_profile_name").(string)
 	domainID := d.Get("domain_id").(string)
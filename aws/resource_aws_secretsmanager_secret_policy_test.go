@@ -26,7 +26,7 @@ func testSweepSecretsManagerSecretPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).secretsmanagerconn
+	conn := client.(*AWSClient).secretsmanagerconn()
 
 	err = conn.ListSecretsPages(&secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, isLast bool) bool {
 		if len(page.SecretList) == 0 {
@@ -163,7 +163,7 @@ func TestAccAwsSecretsManagerSecretPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsSecretsManagerSecretPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_secretsmanager_secret_policy" {
@@ -235,7 +235,7 @@ func testAccCheckAwsSecretsManagerSecretPolicyExists(resourceName string, policy
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 		input := &secretsmanager.GetResourcePolicyInput{
 			SecretId: aws.String(rs.Primary.ID),
 		}
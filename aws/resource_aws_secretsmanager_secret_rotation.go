@@ -54,7 +54,7 @@ func resourceAwsSecretsManagerSecretRotation() *schema.Resource {
 }
 
 func resourceAwsSecretsManagerSecretRotationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 	secretID := d.Get("secret_id").(string)
 
 	if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
@@ -93,7 +93,7 @@ func resourceAwsSecretsManagerSecretRotationCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsSecretsManagerSecretRotationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 
 	input := &secretsmanager.DescribeSecretInput{
 		SecretId: aws.String(d.Id()),
@@ -127,7 +127,7 @@ func resourceAwsSecretsManagerSecretRotationRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsSecretsManagerSecretRotationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 	secretID := d.Get("secret_id").(string)
 
 	if d.HasChanges("rotation_lambda_arn", "rotation_rules") {
@@ -175,7 +175,7 @@ func resourceAwsSecretsManagerSecretRotationUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsSecretsManagerSecretRotationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 	secretID := d.Get("secret_id").(string)
 
 	input := &secretsmanager.CancelRotateSecretInput{
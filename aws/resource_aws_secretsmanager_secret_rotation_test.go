@@ -57,7 +57,7 @@ func TestAccAwsSecretsManagerSecretRotation_basic(t *testing.T) {
 }
 
 func testAccCheckAwsSecretsManagerSecretRotationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_secretsmanager_secret_rotation" {
@@ -92,7 +92,7 @@ func testAccCheckAwsSecretsManagerSecretRotationExists(resourceName string, secr
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 		input := &secretsmanager.DescribeSecretInput{
 			SecretId: aws.String(rs.Primary.ID),
 		}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -146,10 +147,15 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 }
 
 func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	sg_id := d.Get("security_group_id").(string)
 
-	awsMutexKV.Lock(sg_id)
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := awsMutexKV.LockContext(ctx, sg_id); err != nil {
+		return fmt.Errorf("error locking Security Group (%s): %w", sg_id, err)
+	}
 	defer awsMutexKV.Unlock(sg_id)
 
 	sg, err := findResourceSecurityGroup(conn, sg_id)
@@ -278,7 +284,7 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 }
 
 func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	sg_id := d.Get("security_group_id").(string)
 	sg, err := findResourceSecurityGroup(conn, sg_id)
 	if _, notFound := err.(securityGroupNotFound); notFound {
@@ -342,7 +348,7 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	if d.HasChange("description") {
 		if err := resourceSecurityGroupRuleDescriptionUpdate(conn, d); err != nil {
@@ -354,10 +360,15 @@ func resourceAwsSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	sg_id := d.Get("security_group_id").(string)
 
-	awsMutexKV.Lock(sg_id)
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if err := awsMutexKV.LockContext(ctx, sg_id); err != nil {
+		return fmt.Errorf("error locking Security Group (%s): %w", sg_id, err)
+	}
 	defer awsMutexKV.Unlock(sg_id)
 
 	sg, err := findResourceSecurityGroup(conn, sg_id)
@@ -896,7 +907,12 @@ func validateAwsSecurityGroupRule(d *schema.ResourceData) error {
 func resourceSecurityGroupRuleDescriptionUpdate(conn *ec2.EC2, d *schema.ResourceData) error {
 	sg_id := d.Get("security_group_id").(string)
 
-	awsMutexKV.Lock(sg_id)
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if err := awsMutexKV.LockContext(ctx, sg_id); err != nil {
+		return fmt.Errorf("error locking Security Group (%s): %w", sg_id, err)
+	}
 	defer awsMutexKV.Unlock(sg_id)
 
 	sg, err := findResourceSecurityGroup(conn, sg_id)
@@ -52,7 +52,7 @@ func resourceAwsSecurityHubActionTarget() *schema.Resource {
 }
 
 func resourceAwsSecurityHubActionTargetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	description := d.Get("description").(string)
 	name := d.Get("name").(string)
 	identifier := d.Get("identifier").(string)
@@ -85,7 +85,7 @@ func resourceAwsSecurityHubActionTargetParseIdentifier(identifier string) (strin
 }
 
 func resourceAwsSecurityHubActionTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	log.Printf("[DEBUG] Reading Security Hub custom action targets to find %s", d.Id())
 
@@ -116,7 +116,7 @@ func resourceAwsSecurityHubActionTargetRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsSecurityHubActionTargetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	input := &securityhub.UpdateActionTargetInput{
 		ActionTargetArn: aws.String(d.Id()),
@@ -152,7 +152,7 @@ func resourceAwsSecurityHubActionTargetCheckExists(conn *securityhub.SecurityHub
 }
 
 func resourceAwsSecurityHubActionTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Printf("[DEBUG] Deleting Security Hub custom action target %s", d.Id())
 
 	_, err := conn.DeleteActionTarget(&securityhub.DeleteActionTargetInput{
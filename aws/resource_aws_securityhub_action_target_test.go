@@ -128,7 +128,7 @@ func testAccCheckAwsSecurityHubActionTargetExists(n string) resource.TestCheckFu
 			return fmt.Errorf("No Security Hub custom action ARN is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 		action, err := resourceAwsSecurityHubActionTargetCheckExists(conn, rs.Primary.ID)
 
@@ -145,7 +145,7 @@ func testAccCheckAwsSecurityHubActionTargetExists(n string) resource.TestCheckFu
 }
 
 func testAccCheckAwsSecurityHubActionTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_action_target" {
@@ -59,7 +59,7 @@ func resourceAwsSecurityHubMember() *schema.Resource {
 }
 
 func resourceAwsSecurityHubMemberCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Printf("[DEBUG] Creating Security Hub member %s", d.Get("account_id").(string))
 
 	resp, err := conn.CreateMembers(&securityhub.CreateMembersInput{
@@ -100,7 +100,7 @@ func resourceAwsSecurityHubMemberCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSecurityHubMemberRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	log.Printf("[DEBUG] Reading Security Hub member %s", d.Id())
 	resp, err := conn.GetMembers(&securityhub.GetMembersInput{
@@ -138,7 +138,7 @@ func resourceAwsSecurityHubMemberRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSecurityHubMemberDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Printf("[DEBUG] Deleting Security Hub member: %s", d.Id())
 
 	resp, err := conn.DeleteMembers(&securityhub.DeleteMembersInput{
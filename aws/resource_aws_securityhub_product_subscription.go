@@ -35,7 +35,7 @@ func resourceAwsSecurityHubProductSubscription() *schema.Resource {
 }
 
 func resourceAwsSecurityHubProductSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	productArn := d.Get("product_arn").(string)
 
 	log.Printf("[DEBUG] Enabling Security Hub product subscription for product %s", productArn)
@@ -54,7 +54,7 @@ func resourceAwsSecurityHubProductSubscriptionCreate(d *schema.ResourceData, met
 }
 
 func resourceAwsSecurityHubProductSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	productArn, productSubscriptionArn, err := resourceAwsSecurityHubProductSubscriptionParseId(d.Id())
 
@@ -114,7 +114,7 @@ func resourceAwsSecurityHubProductSubscriptionParseId(id string) (string, string
 }
 
 func resourceAwsSecurityHubProductSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Printf("[DEBUG] Disabling Security Hub product subscription %s", d.Id())
 
 	_, productSubscriptionArn, err := resourceAwsSecurityHubProductSubscriptionParseId(d.Id())
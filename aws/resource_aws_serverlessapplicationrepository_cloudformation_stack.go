@@ -82,13 +82,15 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Res
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 
 	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
 	if err != nil {
@@ -121,9 +123,9 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *sche
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn
-	cfConn := meta.(*AWSClient).cfconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn()
+	cfConn := meta.(*AWSClient).cfconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	stack, err := cffinder.Stack(cfConn, d.Id())
 	if tfresource.NotFound(err) {
@@ -206,7 +208,7 @@ func flattenServerlessRepositoryParameterDefinitions(parameterDefinitions []*ser
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 
 	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
 	if err != nil {
@@ -237,7 +239,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *sche
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	requestToken := resource.UniqueId()
 	input := &cloudformation.DeleteStackInput{
@@ -273,7 +275,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 		}
 	}
 
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 	stack, err := cffinder.Stack(cfConn, stackID)
 	if err != nil {
 		return nil, fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): %w", stackID, err)
@@ -285,8 +287,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 }
 
 func createServerlessApplicationRepositoryCloudFormationChangeSet(d *schema.ResourceData, client *AWSClient) (*cloudformation.DescribeChangeSetOutput, error) {
-	serverlessConn := client.serverlessapplicationrepositoryconn
-	cfConn := client.cfconn
+	serverlessConn := client.serverlessapplicationrepositoryconn()
+	cfConn := client.cfconn()
 
 	stackName := d.Get("name").(string)
 	changeSetRequest := serverlessrepository.CreateCloudFormationChangeSetRequest{
@@ -30,7 +30,7 @@ func testSweepServiceDiscoveryHttpNamespaces(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sdconn
+	conn := client.(*AWSClient).sdconn()
 	var sweeperErrs *multierror.Error
 
 	input := &servicediscovery.ListNamespacesInput{
@@ -212,7 +212,7 @@ func TestAccAWSServiceDiscoveryHttpNamespace_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryHttpNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_http_namespace" {
@@ -241,7 +241,7 @@ func testAccCheckAwsServiceDiscoveryHttpNamespaceExists(name string) resource.Te
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 		input := &servicediscovery.GetNamespaceInput{
 			Id: aws.String(rs.Primary.ID),
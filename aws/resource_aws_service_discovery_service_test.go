@@ -26,7 +26,7 @@ func testSweepServiceDiscoveryServices(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sdconn
+	conn := client.(*AWSClient).sdconn()
 	var sweeperErrs *multierror.Error
 
 	input := &servicediscovery.ListServicesInput{}
@@ -312,7 +312,7 @@ func TestAccAWSServiceDiscoveryService_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_service" {
@@ -341,7 +341,7 @@ func testAccCheckAwsServiceDiscoveryServiceExists(name string) resource.TestChec
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 		input := &servicediscovery.GetServiceInput{
 			Id: aws.String(rs.Primary.ID),
@@ -90,7 +90,7 @@ func testAccCheckAwsSesDomainIdentityVerificationPassed(n string) resource.TestC
 		}
 
 		domain := rs.Primary.ID
-		conn := testAccProvider.Meta().(*AWSClient).sesconn
+		conn := testAccProvider.Meta().(*AWSClient).sesconn()
 
 		params := &ses.GetIdentityVerificationAttributesInput{
 			Identities: []*string{
@@ -35,13 +35,15 @@ func resourceAwsSfnActivity() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsSfnActivityCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	log.Print("[DEBUG] Creating Step Function Activity")
 
 	params := &sfn.CreateActivityInput{
@@ -60,10 +62,10 @@ func resourceAwsSfnActivityCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSfnActivityUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.SfnUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -73,8 +75,8 @@ func resourceAwsSfnActivityUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSfnActivityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).sfnconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	log.Printf("[DEBUG] Reading Step Function Activity: %s", d.Id())
 
@@ -109,7 +111,7 @@ func resourceAwsSfnActivityRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsSfnActivityDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	log.Printf("[DEBUG] Deleting Step Functions Activity: %s", d.Id())
 
 	input := &sfn.DeleteActivityInput{
@@ -195,7 +195,7 @@ func resourceAwsSignerSigningJob() *schema.Resource {
 }
 
 func resourceAwsSignerSigningJobCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 	profileName := d.Get("profile_name")
 	source := d.Get("source").([]interface{})
 	destination := d.Get("destination").([]interface{})
@@ -231,7 +231,7 @@ func resourceAwsSignerSigningJobCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSignerSigningJobRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 	jobId := d.Id()
 
 	describeSigningJobOutput, err := conn.DescribeSigningJob(&signer.DescribeSigningJobInput{
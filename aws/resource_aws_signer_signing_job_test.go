@@ -99,7 +99,7 @@ func testAccCheckAWSSignerSigningJobExists(res string, job *signer.DescribeSigni
 			return fmt.Errorf("Signing job with that ID does not exist")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).signerconn
+		conn := testAccProvider.Meta().(*AWSClient).signerconn()
 
 		params := &signer.DescribeSigningJobInput{
 			JobId: aws.String(rs.Primary.ID),
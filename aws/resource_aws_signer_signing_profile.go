@@ -73,7 +73,9 @@ func resourceAwsSignerSigningProfile() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,7 +121,7 @@ func resourceAwsSignerSigningProfile() *schema.Resource {
 }
 
 func resourceAwsSignerSigningProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 
 	log.Printf("[DEBUG] Creating Signer signing profile")
 
@@ -154,8 +156,8 @@ func resourceAwsSignerSigningProfileCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSignerSigningProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).signerconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	signingProfileOutput, err := conn.GetSigningProfile(&signer.GetSigningProfileInput{
 		ProfileName: aws.String(d.Id()),
@@ -220,11 +222,11 @@ func resourceAwsSignerSigningProfileRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSignerSigningProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 
 	arn := d.Get("arn").(string)
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SignerUpdateTags(conn, arn, o, n); err != nil {
 			return fmt.Errorf("error updating Signer signing profile (%s) tags: %s", arn, err)
@@ -235,7 +237,7 @@ func resourceAwsSignerSigningProfileUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSignerSigningProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 
 	_, err := conn.CancelSigningProfile(&signer.CancelSigningProfileInput{
 		ProfileName: aws.String(d.Id()),
@@ -149,7 +149,7 @@ func TestAccAWSSignerSigningProfile_SignatureValidityPeriod(t *testing.T) {
 }
 
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
-	conn := testAccProvider.Meta().(*AWSClient).signerconn
+	conn := testAccProvider.Meta().(*AWSClient).signerconn()
 
 	input := &signer.ListSigningPlatformsInput{}
 
@@ -269,7 +269,7 @@ func testAccCheckAWSSignerSigningProfileExists(res string, sp *signer.GetSigning
 			return fmt.Errorf("Signing Profile with that ARN does not exist")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).signerconn
+		conn := testAccProvider.Meta().(*AWSClient).signerconn()
 
 		params := &signer.GetSigningProfileInput{
 			ProfileName: aws.String(rs.Primary.ID),
@@ -287,7 +287,7 @@ func testAccCheckAWSSignerSigningProfileExists(res string, sp *signer.GetSigning
 }
 
 func testAccCheckAWSSignerSigningProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).signerconn
+	conn := testAccProvider.Meta().(*AWSClient).signerconn()
 
 	time.Sleep(5 * time.Second)
 
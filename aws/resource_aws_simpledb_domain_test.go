@@ -35,7 +35,7 @@ func TestAccAWSSimpleDBDomain_basic(t *testing.T) {
 }
 
 func testAccCheckAWSSimpleDBDomainDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).simpledbconn
+	conn := testAccProvider.Meta().(*AWSClient).simpledbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_simpledb_domain" {
@@ -71,7 +71,7 @@ func testAccCheckAWSSimpleDBDomainExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No SimpleDB domain with that name exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).simpledbconn
+		conn := testAccProvider.Meta().(*AWSClient).simpledbconn()
 		input := &simpledb.DomainMetadataInput{
 			DomainName: aws.String(rs.Primary.ID),
 		}
@@ -34,7 +34,7 @@ func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
 }
 
 func resourceAwsSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -73,7 +73,7 @@ func resourceAwsSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, met
 }
 
 func resourceAwsSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	snapshotID, accountID, err := resourceAwsSnapshotCreateVolumePermissionParseID(d.Id())
 	if err != nil {
@@ -94,7 +94,7 @@ func resourceAwsSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	snapshotID, accountID, err := resourceAwsSnapshotCreateVolumePermissionParseID(d.Id())
 	if err != nil {
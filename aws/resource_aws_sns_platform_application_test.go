@@ -29,7 +29,7 @@ func testSweepSnsPlatformApplications(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).snsconn
+	conn := client.(*AWSClient).snsconn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListPlatformApplicationsPages(&sns.ListPlatformApplicationsInput{}, func(page *sns.ListPlatformApplicationsOutput, isLast bool) bool {
@@ -421,7 +421,7 @@ func testAccCheckAwsSnsPlatformApplicationExists(name string) resource.TestCheck
 			return fmt.Errorf("missing ID: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		input := &sns.GetPlatformApplicationAttributesInput{
 			PlatformApplicationArn: aws.String(rs.Primary.ID),
@@ -435,7 +435,7 @@ func testAccCheckAwsSnsPlatformApplicationExists(name string) resource.TestCheck
 }
 
 func testAccCheckAWSSNSPlatformApplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).snsconn
+	conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_platform_application" {
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
 func init() {
@@ -37,7 +36,7 @@ func testSweepSqsQueues(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).sqsconn
+	conn := client.(*AWSClient).sqsconn()
 	input := &sqs.ListQueuesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -453,7 +452,7 @@ func TestAccAWSSQSQueue_Encryption(t *testing.T) {
 }
 
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+	conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
@@ -496,7 +495,7 @@ func testAccCheckAWSSQSQueuePolicyAttribute(queueAttributes *map[string]*string,
 			}
 		}
 
-		equivalent, err := awspolicy.PoliciesAreEquivalent(actualPolicyText, expectedPolicyText)
+		equivalent, err := iamPoliciesAreEquivalent(actualPolicyText, expectedPolicyText)
 		if err != nil {
 			return fmt.Errorf("Error testing policy equivalence: %s", err)
 		}
@@ -520,7 +519,7 @@ func testAccCheckAWSSQSQueueExists(resourceName string, queueAttributes *map[str
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 		input := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
@@ -7,11 +7,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsSsmActivation() *schema.Resource {
@@ -63,13 +63,15 @@ func resourceAwsSsmActivation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchemaForceNew(),
+			"tags":        tagsSchemaForceNew(),
+			"tags_all":    tagsSchemaTrulyComputedForceNew(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] SSM activation create: %s", d.Id())
 
@@ -102,31 +104,16 @@ func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	// Retry to allow iam_role to be created and policy attachment to take place
-	var resp *ssm.CreateActivationOutput
-	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
-		var err error
-
-		resp, err = ssmconn.CreateActivation(activationInput)
-
-		if isAWSErr(err, "ValidationException", "Not existing role") {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if isResourceTimeoutError(err) {
-		resp, err = ssmconn.CreateActivation(activationInput)
-	}
+	outputRaw, err := tfresource.RetryWhenAwsErrMessageContains(iamwaiter.PropagationTimeout, func() (interface{}, error) {
+		return ssmconn.CreateActivation(activationInput)
+	}, "ValidationException", "Not existing role")
 
 	if err != nil {
 		return fmt.Errorf("Error creating SSM activation: %s", err)
 	}
 
+	resp := outputRaw.(*ssm.CreateActivationOutput)
+
 	if resp.ActivationId == nil {
 		return fmt.Errorf("ActivationId was nil")
 	}
@@ -137,8 +124,8 @@ func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSsmActivationRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	ssmconn := meta.(*AWSClient).ssmconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	log.Printf("[DEBUG] Reading SSM Activation: %s", d.Id())
 
@@ -181,7 +168,7 @@ func resourceAwsSsmActivationRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSsmActivationDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] Deleting SSM Activation: %s", d.Id())
 
@@ -167,7 +167,7 @@ func testAccCheckAWSSSMActivationExists(n string, ssmActivation *ssm.Activation)
 			return fmt.Errorf("No SSM Activation ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		resp, err := conn.DescribeActivations(&ssm.DescribeActivationsInput{
 			Filters: []*ssm.DescribeActivationsFilter{
@@ -193,7 +193,7 @@ func testAccCheckAWSSSMActivationExists(n string, ssmActivation *ssm.Activation)
 
 func testAccCheckAWSSSMActivationDisappears(a *ssm.Activation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		input := &ssm.DeleteActivationInput{ActivationId: a.ActivationId}
 		_, err := conn.DeleteActivation(input)
@@ -205,7 +205,7 @@ func testAccCheckAWSSSMActivationDisappears(a *ssm.Activation) resource.TestChec
 }
 
 func testAccCheckAWSSSMActivationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_activation" {
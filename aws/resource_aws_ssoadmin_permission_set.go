@@ -80,13 +80,15 @@ func resourceAwsSsoAdminPermissionSet() *schema.Resource {
 				Default:      "PT1H",
 			},
 
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsSsoAdminPermissionSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	instanceArn := d.Get("instance_arn").(string)
 	name := d.Get("name").(string)
@@ -127,8 +129,8 @@ func resourceAwsSsoAdminPermissionSetCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsSsoAdminPermissionSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).ssoadminconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	arn, instanceArn, err := parseSsoAdminResourceID(d.Id())
 	if err != nil {
@@ -177,7 +179,7 @@ func resourceAwsSsoAdminPermissionSetRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsSsoAdminPermissionSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	arn, instanceArn, err := parseSsoAdminResourceID(d.Id())
 	if err != nil {
@@ -208,8 +210,8 @@ func resourceAwsSsoAdminPermissionSetUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.SsoadminUpdateTags(conn, arn, instanceArn, o, n); err != nil {
 			return fmt.Errorf("error updating tags: %w", err)
 		}
@@ -224,7 +226,7 @@ func resourceAwsSsoAdminPermissionSetUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsSsoAdminPermissionSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	arn, instanceArn, err := parseSsoAdminResourceID(d.Id())
 	if err != nil {
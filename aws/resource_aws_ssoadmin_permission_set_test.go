@@ -31,7 +31,7 @@ func testSweepSsoAdminPermissionSets(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).ssoadminconn
+	conn := client.(*AWSClient).ssoadminconn()
 	var sweeperErrs *multierror.Error
 
 	// Need to Read the SSO Instance first; assumes the first instance returned
@@ -303,7 +303,7 @@ func TestAccAWSSSOAdminPermissionSet_mixedPolicyAttachments(t *testing.T) {
 }
 
 func testAccCheckAWSSSOAdminPermissionSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssoadmin_permission_set" {
@@ -348,7 +348,7 @@ func testAccCheckAWSSOAdminPermissionSetExists(resourceName string) resource.Tes
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn()
 
 		arn, instanceArn, err := parseSsoAdminResourceID(rs.Primary.ID)
 
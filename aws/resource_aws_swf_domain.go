@@ -58,13 +58,15 @@ func resourceAwsSwfDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsSwfDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).swfconn
+	conn := meta.(*AWSClient).swfconn()
 
 	var name string
 
@@ -97,8 +99,8 @@ func resourceAwsSwfDomainCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsSwfDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).swfconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).swfconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &swf.DescribeDomainInput{
 		Name: aws.String(d.Id()),
@@ -140,10 +142,10 @@ func resourceAwsSwfDomainRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSwfDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).swfconn
+	conn := meta.(*AWSClient).swfconn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SwfUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating SWF Domain (%s) tags: %s", d.Id(), err)
@@ -154,7 +156,7 @@ func resourceAwsSwfDomainUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsSwfDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).swfconn
+	conn := meta.(*AWSClient).swfconn()
 
 	input := &swf.DeprecateDomainInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -181,7 +181,7 @@ func TestAccAWSSwfDomain_Description(t *testing.T) {
 }
 
 func testAccCheckAwsSwfDomainDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).swfconn
+	conn := testAccProvider.Meta().(*AWSClient).swfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_swf_domain" {
@@ -218,7 +218,7 @@ func testAccCheckAwsSwfDomainExists(n string) resource.TestCheckFunc {
 		}
 
 		name := rs.Primary.ID
-		conn := testAccProvider.Meta().(*AWSClient).swfconn
+		conn := testAccProvider.Meta().(*AWSClient).swfconn()
 
 		input := &swf.DescribeDomainInput{
 			Name: aws.String(name),
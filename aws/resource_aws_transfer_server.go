@@ -128,14 +128,16 @@ func resourceAwsTransferServer() *schema.Resource {
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) error {
 	updateAfterCreate := false
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().TransferTags()
 	createOpts := &transfer.CreateServerInput{}
 
@@ -254,8 +256,8 @@ func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).transferconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	descOpts := &transfer.DescribeServerInput{
 		ServerId: aws.String(d.Id()),
@@ -296,7 +298,7 @@ func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	updateFlag := false
 	stopFlag := false
 	updateOpts := &transfer.UpdateServerInput{
@@ -365,8 +367,8 @@ func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.TransferUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -376,7 +378,7 @@ func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsTransferServerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 
 	if d.Get("force_destroy").(bool) {
 		log.Printf("[DEBUG] Transfer Server (%s) attempting to forceDestroy", d.Id())
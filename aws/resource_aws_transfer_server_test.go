@@ -26,7 +26,7 @@ func testSweepTransferServers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).transferconn
+	conn := client.(*AWSClient).transferconn()
 	input := &transfer.ListServersInput{}
 
 	err = conn.ListServersPages(input, func(page *transfer.ListServersOutput, lastPage bool) bool {
@@ -306,7 +306,7 @@ func testAccCheckAWSTransferServerExists(n string, res *transfer.DescribedServer
 			return fmt.Errorf("No Transfer Server ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		describe, err := conn.DescribeServer(&transfer.DescribeServerInput{
 			ServerId: aws.String(rs.Primary.ID),
@@ -324,7 +324,7 @@ func testAccCheckAWSTransferServerExists(n string, res *transfer.DescribedServer
 
 func testAccCheckAWSTransferServerDisappears(conf *transfer.DescribedServer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		params := &transfer.DeleteServerInput{
 			ServerId: conf.ServerId,
@@ -340,7 +340,7 @@ func testAccCheckAWSTransferServerDisappears(conf *transfer.DescribedServer) res
 }
 
 func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_server" {
@@ -365,7 +365,7 @@ func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
 
 func testAccCheckAWSTransferCreateUser(describedServer *transfer.DescribedServer, getRoleOutput *iam.GetRoleOutput, userName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		input := &transfer.CreateUserInput{
 			ServerId: describedServer.ServerId,
@@ -383,7 +383,7 @@ func testAccCheckAWSTransferCreateUser(describedServer *transfer.DescribedServer
 
 func testAccCheckAWSTransferCreateSshKey(describedServer *transfer.DescribedServer, userName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		input := &transfer.ImportSshPublicKeyInput{
 			ServerId:         describedServer.ServerId,
@@ -400,7 +400,7 @@ func testAccCheckAWSTransferCreateSshKey(describedServer *transfer.DescribedServ
 }
 
 func testAccPreCheckAWSTransfer(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	input := &transfer.ListServersInput{}
 
@@ -66,7 +66,7 @@ func resourceAwsTransferUser() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ValidateFunc:     validateIAMPolicyJson,
+				ValidateFunc:     validateIAMPolicyDocument(iamPolicyTypeTransferUser),
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 
@@ -83,7 +83,10 @@ func resourceAwsTransferUser() *schema.Resource {
 				ValidateFunc: validateTransferServerID,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"ignore_tags": ignoreTagsSchema(),
 
 			"user_name": {
 				Type:         schema.TypeString,
@@ -96,7 +99,7 @@ func resourceAwsTransferUser() *schema.Resource {
 }
 
 func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	userName := d.Get("user_name").(string)
 	serverID := d.Get("server_id").(string)
 
@@ -139,8 +142,8 @@ func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsTransferUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).transferconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	serverID, userName, err := decodeTransferUserId(d.Id())
 	if err != nil {
@@ -183,7 +186,7 @@ func resourceAwsTransferUserRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsTransferUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	updateFlag := false
 	serverID, userName, err := decodeTransferUserId(d.Id())
 	if err != nil {
@@ -232,8 +235,8 @@ func resourceAwsTransferUserUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.TransferUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -243,7 +246,7 @@ func resourceAwsTransferUserUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsTransferUserDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	serverID, userName, err := decodeTransferUserId(d.Id())
 	if err != nil {
 		return fmt.Errorf("error parsing Transfer User ID: %s", err)
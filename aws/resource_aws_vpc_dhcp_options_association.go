@@ -34,7 +34,7 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 }
 
 func resourceAwsVpcDhcpOptionsAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	// Provide the vpc_id as the id to import
 	vpcRaw, _, err := VPCStateRefreshFunc(conn, d.Id())()
 	if err != nil {
@@ -55,7 +55,7 @@ func resourceAwsVpcDhcpOptionsAssociationImport(d *schema.ResourceData, meta int
 }
 
 func resourceAwsVpcDhcpOptionsAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	vpcId := d.Get("vpc_id").(string)
 	optsID := d.Get("dhcp_options_id").(string)
@@ -78,7 +78,7 @@ func resourceAwsVpcDhcpOptionsAssociationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsVpcDhcpOptionsAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	// Get the VPC that this association belongs to
 	vpcRaw, _, err := VPCStateRefreshFunc(conn, d.Get("vpc_id").(string))()
 
@@ -111,7 +111,7 @@ func resourceAwsVpcDhcpOptionsAssociationUpdate(d *schema.ResourceData, meta int
 // AWS does not provide an API to disassociate a DHCP Options set from a VPC.
 // So, we do this by setting the VPC to the default DHCP Options Set.
 func resourceAwsVpcDhcpOptionsAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[INFO] Disassociating DHCP Options Set %s from VPC %s...", d.Get("dhcp_options_id"), d.Get("vpc_id"))
 	_, err := conn.AssociateDhcpOptions(&ec2.AssociateDhcpOptionsInput{
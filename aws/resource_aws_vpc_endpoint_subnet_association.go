@@ -43,7 +43,7 @@ func resourceAwsVpcEndpointSubnetAssociation() *schema.Resource {
 }
 
 func resourceAwsVpcEndpointSubnetAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	endpointId := d.Get("vpc_endpoint_id").(string)
 	snId := d.Get("subnet_id").(string)
@@ -86,7 +86,7 @@ func resourceAwsVpcEndpointSubnetAssociationCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsVpcEndpointSubnetAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	endpointId := d.Get("vpc_endpoint_id").(string)
 	snId := d.Get("subnet_id").(string)
@@ -119,7 +119,7 @@ func resourceAwsVpcEndpointSubnetAssociationRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsVpcEndpointSubnetAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	endpointId := d.Get("vpc_endpoint_id").(string)
 	snId := d.Get("subnet_id").(string)
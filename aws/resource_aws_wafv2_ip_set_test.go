@@ -31,7 +31,7 @@ func testSweepWafv2IpSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafv2conn
+	conn := client.(*AWSClient).wafv2conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -339,7 +339,7 @@ func testAccCheckAWSWafv2IPSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetIPSet(
 			&wafv2.GetIPSetInput{
 				Id:    aws.String(rs.Primary.ID),
@@ -379,7 +379,7 @@ func testAccCheckAWSWafv2IPSetExists(n string, v *wafv2.IPSet) resource.TestChec
 			return fmt.Errorf("No WAFv2 IPSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetIPSet(&wafv2.GetIPSetInput{
 			Id:    aws.String(rs.Primary.ID),
 			Name:  aws.String(rs.Primary.Attributes["name"]),
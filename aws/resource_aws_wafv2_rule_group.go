@@ -107,13 +107,15 @@ func resourceAwsWafv2RuleGroup() *schema.Resource {
 				},
 			},
 			"tags":              tagsSchema(),
+			"tags_all":          tagsSchemaTrulyComputed(),
+			"ignore_tags":       ignoreTagsSchema(),
 			"visibility_config": wafv2VisibilityConfigSchema(),
 		},
 	}
 }
 
 func resourceAwsWafv2RuleGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 	var resp *wafv2.CreateRuleGroupOutput
 
 	params := &wafv2.CreateRuleGroupInput{
@@ -162,8 +164,8 @@ func resourceAwsWafv2RuleGroupCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsWafv2RuleGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).wafv2conn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	params := &wafv2.GetRuleGroupInput{
 		Id:    aws.String(d.Id()),
@@ -213,7 +215,7 @@ func resourceAwsWafv2RuleGroupRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsWafv2RuleGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 
 	log.Printf("[INFO] Updating WAFv2 RuleGroup %s", d.Id())
 
@@ -249,8 +251,8 @@ func resourceAwsWafv2RuleGroupUpdate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error updating WAFv2 RuleGroup: %s", err)
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.Wafv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("Error updating tags: %s", err)
 		}
@@ -260,7 +262,7 @@ func resourceAwsWafv2RuleGroupUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsWafv2RuleGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 
 	log.Printf("[INFO] Deleting WAFv2 RuleGroup %s", d.Id())
 
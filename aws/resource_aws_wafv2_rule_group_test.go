@@ -30,7 +30,7 @@ func testSweepWafv2RuleGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafv2conn
+	conn := client.(*AWSClient).wafv2conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1425,7 +1425,7 @@ func TestAccAwsWafv2RuleGroup_XssMatchStatement(t *testing.T) {
 }
 
 func testAccPreCheckAWSWafv2ScopeRegional(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+	conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 
 	input := &wafv2.ListRuleGroupsInput{
 		Scope: aws.String(wafv2.ScopeRegional),
@@ -1448,7 +1448,7 @@ func testAccCheckAwsWafv2RuleGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetRuleGroup(
 			&wafv2.GetRuleGroupInput{
 				Id:    aws.String(rs.Primary.ID),
@@ -1490,7 +1490,7 @@ func testAccCheckAwsWafv2RuleGroupExists(n string, v *wafv2.RuleGroup) resource.
 			return fmt.Errorf("No WAFv2 RuleGroup ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetRuleGroup(&wafv2.GetRuleGroupInput{
 			Id:    aws.String(rs.Primary.ID),
 			Name:  aws.String(rs.Primary.Attributes["name"]),
@@ -48,7 +48,7 @@ func resourceAwsWorkLinkWebsiteCertificateAuthorityAssociation() *schema.Resourc
 }
 
 func resourceAwsWorkLinkWebsiteCertificateAuthorityAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).worklinkconn
+	conn := meta.(*AWSClient).worklinkconn()
 
 	input := &worklink.AssociateWebsiteCertificateAuthorityInput{
 		FleetArn:    aws.String(d.Get("fleet_arn").(string)),
@@ -70,7 +70,7 @@ func resourceAwsWorkLinkWebsiteCertificateAuthorityAssociationCreate(d *schema.R
 }
 
 func resourceAwsWorkLinkWebsiteCertificateAuthorityAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).worklinkconn
+	conn := meta.(*AWSClient).worklinkconn()
 
 	fleetArn, websiteCaID, err := decodeWorkLinkWebsiteCertificateAuthorityAssociationResourceID(d.Id())
 	if err != nil {
@@ -101,7 +101,7 @@ func resourceAwsWorkLinkWebsiteCertificateAuthorityAssociationRead(d *schema.Res
 }
 
 func resourceAwsWorkLinkWebsiteCertificateAuthorityAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).worklinkconn
+	conn := meta.(*AWSClient).worklinkconn()
 
 	fleetArn, websiteCaID, err := decodeWorkLinkWebsiteCertificateAuthorityAssociationResourceID(d.Id())
 	if err != nil {
@@ -28,7 +28,7 @@ func testSweepWorkspacesDirectories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).workspacesconn
+	conn := client.(*AWSClient).workspacesconn()
 
 	var errors error
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
@@ -679,7 +679,7 @@ func TestFlattenWorkspaceCreationProperties(t *testing.T) {
 }
 
 func testAccPreCheckHasIAMRole(t *testing.T, roleName string) {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	input := &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
@@ -698,7 +698,7 @@ func testAccPreCheckHasIAMRole(t *testing.T, roleName string) {
 }
 
 func testAccCheckAwsWorkspacesDirectoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_workspaces_directory" {
@@ -727,7 +727,7 @@ func testAccCheckAwsWorkspacesDirectoryDestroy(s *terraform.State) error {
 
 func testAccCheckAwsWorkspacesDirectoryDisappears(v *workspaces.WorkspaceDirectory) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		return workspacesDirectoryDelete(aws.StringValue(v.DirectoryId), testAccProvider.Meta().(*AWSClient).workspacesconn)
+		return workspacesDirectoryDelete(aws.StringValue(v.DirectoryId), testAccProvider.Meta().(*AWSClient).workspacesconn())
 	}
 }
 
@@ -742,7 +742,7 @@ func testAccCheckAwsWorkspacesDirectoryExists(n string, v *workspaces.WorkspaceD
 			return fmt.Errorf("workspaces directory resource ID is not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 		resp, err := conn.DescribeWorkspaceDirectories(&workspaces.DescribeWorkspaceDirectoriesInput{
 			DirectoryIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -760,7 +760,7 @@ func testAccCheckAwsWorkspacesDirectoryExists(n string, v *workspaces.WorkspaceD
 }
 
 func testAccPreCheckWorkspacesDirectory(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
 
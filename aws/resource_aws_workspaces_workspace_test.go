@@ -28,7 +28,7 @@ func testSweepWorkspacesWorkspace(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).workspacesconn
+	conn := client.(*AWSClient).workspacesconn()
 
 	var errors error
 	input := &workspaces.DescribeWorkspacesInput{}
@@ -347,7 +347,7 @@ func TestAccAwsWorkspacesWorkspace_timeout(t *testing.T) {
 }
 
 func testAccCheckAwsWorkspacesWorkspaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_workspaces_workspace" {
@@ -381,7 +381,7 @@ func testAccCheckAwsWorkspacesWorkspaceExists(n string, v *workspaces.Workspace)
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 
 		output, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
 			WorkspaceIds: []*string{aws.String(rs.Primary.ID)},
@@ -35,13 +35,15 @@ func resourceAwsXrayGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			"tags": tagsSchema(),
+			"tags":        tagsSchema(),
+			"tags_all":    tagsSchemaTrulyComputed(),
+			"ignore_tags": ignoreTagsSchema(),
 		},
 	}
 }
 
 func resourceAwsXrayGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 	input := &xray.CreateGroupInput{
 		GroupName:        aws.String(d.Get("group_name").(string)),
 		FilterExpression: aws.String(d.Get("filter_expression").(string)),
@@ -59,8 +61,8 @@ func resourceAwsXrayGroupCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsXrayGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	conn := meta.(*AWSClient).xrayconn()
+	ignoreTagsConfig := meta.(*AWSClient).ResourceIgnoreTagsConfig(d)
 
 	input := &xray.GetGroupInput{
 		GroupARN: aws.String(d.Id()),
@@ -95,7 +97,7 @@ func resourceAwsXrayGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsXrayGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 
 	if d.HasChange("filter_expression") {
 		input := &xray.UpdateGroupInput{
@@ -109,8 +111,8 @@ func resourceAwsXrayGroupUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.XrayUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %w", err)
 		}
@@ -120,7 +122,7 @@ func resourceAwsXrayGroupUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsXrayGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 
 	log.Printf("[INFO] Deleting XRay Group: %s", d.Id())
 
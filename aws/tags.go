@@ -1,8 +1,14 @@
 package aws
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
@@ -10,38 +16,285 @@ import (
 // tagsSchema returns the schema to use for tags.
 //
 func tagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeMap,
+		Optional:     true,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		ValidateFunc: validateTags,
+	}
+}
+
+func tagsSchemaComputed() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
 		Optional: true,
+		Computed: true,
 		Elem:     &schema.Schema{Type: schema.TypeString},
 	}
 }
 
-func tagsSchemaComputed() *schema.Schema {
+// tagsSchemaTrulyComputed returns the schema to use for the computed tags_all attribute,
+// which contains the resource tags merged with any provider default tags.
+func tagsSchemaTrulyComputed() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
-		Optional: true,
 		Computed: true,
 		Elem:     &schema.Schema{Type: schema.TypeString},
 	}
 }
 
-func tagsSchemaForceNew() *schema.Schema {
+// tagsSchemaTrulyComputedForceNew returns the schema to use for the computed tags_all attribute
+// of resources without an Update function, where any change to the tags replaces the resource.
+func tagsSchemaTrulyComputedForceNew() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
-		Optional: true,
+		Computed: true,
 		ForceNew: true,
 		Elem:     &schema.Schema{Type: schema.TypeString},
 	}
 }
 
+func tagsSchemaForceNew() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeMap,
+		Optional:     true,
+		ForceNew:     true,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		ValidateFunc: validateTags,
+	}
+}
+
 func tagsSchemaConflictsWith(conflictsWith []string) *schema.Schema {
 	return &schema.Schema{
 		ConflictsWith: conflictsWith,
 		Type:          schema.TypeMap,
 		Optional:      true,
 		Elem:          &schema.Schema{Type: schema.TypeString},
+		ValidateFunc:  validateTags,
+	}
+}
+
+// CheckTagPolicyDiff returns an error if the planned tags of a new resource, or the changed tags of an existing resource,
+// merged with the provider default tags, do not comply with the provider tag policy.
+// The provider sets this as (or within) the CustomizeDiff of all resources with a tags attribute.
+func CheckTagPolicyDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*AWSClient)
+
+	if client.TagPolicyConfig == nil {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("tags") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
+	allTags := client.DefaultTagsConfig.MergeTags(resourceTags).IgnoreAws()
+
+	if err := allTags.CheckTagPolicy(client.TagPolicyConfig); err != nil {
+		return fmt.Errorf("tags do not comply with tag policy: %w", err)
+	}
+
+	return nil
+}
+
+// validateTags validates tags at plan time against the limits common to all services.
+// Per-service limits, such as the maximum number of tags, are checked by the generated keyvaluetags UpdateTags functions.
+func validateTags(v interface{}, k string) (ws []string, errors []error) {
+	err := keyvaluetags.New(v.(map[string]interface{})).Validate("")
+
+	if err == nil {
+		return
+	}
+
+	if err, ok := err.(*multierror.Error); ok {
+		for _, err := range err.Errors {
+			errors = append(errors, fmt.Errorf("%q: %w", k, err))
+		}
+
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q: %w", k, err))
+
+	return
+}
+
+// ignoreTagsSchema returns the schema to use for resource ignore_tags,
+// which are merged with the provider ignore_tags configuration.
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+				"key_prefixes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+			},
+		},
+	}
+}
+
+// ResourceIgnoreTagsConfig returns the provider ignore tags configuration
+// merged with any ignore_tags configuration of the resource.
+// Resources using ignoreTagsSchema() for ignore_tags should use this when setting tags.
+func (client *AWSClient) ResourceIgnoreTagsConfig(d *schema.ResourceData) *keyvaluetags.IgnoreConfig {
+	l, ok := d.Get("ignore_tags").([]interface{})
+
+	if !ok {
+		return client.IgnoreTagsConfig
 	}
+
+	return client.IgnoreTagsConfig.Merge(expandProviderIgnoreTags(l))
+}
+
+// SetTagsDiff sets the new plan difference for the tags_all attribute
+// from the resource tags merged with the provider default tags,
+// less any tags ignored by the provider or resource ignore_tags configuration.
+// The provider sets this within the CustomizeDiff of all resources with a tags_all attribute.
+func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("error setting tags_all to computed: %w", err)
+		}
+
+		return nil
+	}
+
+	client := meta.(*AWSClient)
+	ignoreTagsConfig := client.IgnoreTagsConfig
+
+	if l, ok := diff.Get("ignore_tags").([]interface{}); ok {
+		ignoreTagsConfig = ignoreTagsConfig.Merge(expandProviderIgnoreTags(l))
+	}
+
+	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
+
+	allTags := client.DefaultTagsConfig.MergeTags(resourceTags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
+		return fmt.Errorf("error setting new tags_all diff: %w", err)
+	}
+
+	return nil
+}
+
+// resourceWithDefaultTags applies the provider default tags to a resource with a tags_all attribute.
+// The provider calls this for all such resources, so that individual resources only need to add
+// "tags_all": tagsSchemaTrulyComputed() next to their tags attribute and check tags_all rather than tags
+// for changes in their Update function.
+// Before Create and Update, the tags attribute is set to the resource tags merged with the default tags,
+// so that the default tags are sent to AWS wherever the resource tags are.
+// After Create, Read and Update, tags_all is set to the tags read back into the tags attribute,
+// and tags to those tags not matching a default tag.
+func resourceWithDefaultTags(r *schema.Resource) {
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = SetTagsDiff
+	} else {
+		r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, SetTagsDiff)
+	}
+
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return withDefaultTags(d, meta, true, func() error { return f(d, meta) })
+		}
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return withDefaultTagsContext(d, meta, true, func() diag.Diagnostics { return f(ctx, d, meta) })
+		}
+	}
+
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return withDefaultTags(d, meta, false, func() error { return f(d, meta) })
+		}
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return withDefaultTagsContext(d, meta, false, func() diag.Diagnostics { return f(ctx, d, meta) })
+		}
+	}
+
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return withDefaultTags(d, meta, true, func() error { return f(d, meta) })
+		}
+	}
+
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return withDefaultTagsContext(d, meta, true, func() diag.Diagnostics { return f(ctx, d, meta) })
+		}
+	}
+}
+
+// withDefaultTags calls the given resource function, merging the default tags into the tags attribute
+// beforehand if merge is set, and setting the tags and tags_all attributes afterwards.
+// An error from the resource function takes precedence over any error setting the attributes.
+func withDefaultTags(d *schema.ResourceData, meta interface{}, merge bool, f func() error) error {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+
+	if merge {
+		tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+		if err := d.Set("tags", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags: %w", err)
+		}
+	}
+
+	err := f()
+
+	if d.Id() == "" {
+		return err
+	}
+
+	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{}))
+
+	//lintignore:AWSR002
+	if setErr := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); setErr != nil && err == nil {
+		err = fmt.Errorf("error setting tags: %w", setErr)
+	}
+
+	if setErr := d.Set("tags_all", tags.Map()); setErr != nil && err == nil {
+		err = fmt.Errorf("error setting tags_all: %w", setErr)
+	}
+
+	return err
+}
+
+// withDefaultTagsContext is the diag.Diagnostics variant of withDefaultTags.
+func withDefaultTagsContext(d *schema.ResourceData, meta interface{}, merge bool, f func() diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := withDefaultTags(d, meta, merge, func() error {
+		diags = f()
+
+		return nil
+	})
+
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
 }
 
 // ec2TagsFromTagDescriptions returns the tags from the given tag descriptions.
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateTags(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tags     map[string]interface{}
+		wantErrs int
+	}{
+		{
+			name: "empty",
+			tags: map[string]interface{}{},
+		},
+		{
+			name: "valid",
+			tags: map[string]interface{}{
+				"Name": "test",
+				"Env":  "",
+			},
+		},
+		{
+			name: "aws prefix",
+			tags: map[string]interface{}{
+				"aws:Name": "test",
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "multiple errors",
+			tags: map[string]interface{}{
+				"aws:Name":               "test",
+				strings.Repeat("k", 129): "test",
+				"Env":                    strings.Repeat("v", 257),
+			},
+			wantErrs: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, errs := validateTags(testCase.tags, "tags")
+
+			if got, want := len(errs), testCase.wantErrs; got != want {
+				t.Errorf("got %d errors, expected %d: %v", got, want, errs)
+			}
+
+			for _, err := range errs {
+				if !strings.HasPrefix(err.Error(), `"tags": `) {
+					t.Errorf("expected error to be prefixed with attribute name: %s", err)
+				}
+			}
+		})
+	}
+}
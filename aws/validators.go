@@ -10,7 +10,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/configservice"
@@ -20,24 +19,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfarn "github.com/terraform-providers/terraform-provider-aws/aws/internal/arn"
 )
 
 const (
-	awsAccountIDRegexpInternalPattern = `(aws|\d{12})`
-	awsPartitionRegexpInternalPattern = `aws(-[a-z]+)*`
-	awsRegionRegexpInternalPattern    = `[a-z]{2}(-[a-z]+)+-\d`
+	awsAccountIDRegexpInternalPattern = tfarn.AccountIDRegexpInternalPattern
+	awsRegionRegexpInternalPattern    = tfarn.RegionRegexpInternalPattern
 )
 
-const (
-	awsAccountIDRegexpPattern = "^" + awsAccountIDRegexpInternalPattern + "$"
-	awsPartitionRegexpPattern = "^" + awsPartitionRegexpInternalPattern + "$"
-	awsRegionRegexpPattern    = "^" + awsRegionRegexpInternalPattern + "$"
-)
-
-var awsAccountIDRegexp = regexp.MustCompile(awsAccountIDRegexpPattern)
-var awsPartitionRegexp = regexp.MustCompile(awsPartitionRegexpPattern)
-var awsRegionRegexp = regexp.MustCompile(awsRegionRegexpPattern)
-
 // validateTypeStringNullableBoolean provides custom error messaging for TypeString booleans
 // Some arguments require three values: true, false, and "" (unspecified).
 // This ValidateFunc returns a custom message since the message with
@@ -679,39 +668,10 @@ func validatePrincipal(v interface{}, k string) (ws []string, errors []error) {
 	return ws, errors
 }
 
+// validateArn checks an ARN has the general ARN format. Prefer the ValidateFunc
+// of a tfarn resource type where the type of resource is known.
 func validateArn(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-
-	if value == "" {
-		return ws, errors
-	}
-
-	parsedARN, err := arn.Parse(value)
-
-	if err != nil {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
-		return ws, errors
-	}
-
-	if parsedARN.Partition == "" {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing partition value", k, value))
-	} else if !awsPartitionRegexp.MatchString(parsedARN.Partition) {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition value (expecting to match regular expression: %s)", k, value, awsPartitionRegexpPattern))
-	}
-
-	if parsedARN.Region != "" && !awsRegionRegexp.MatchString(parsedARN.Region) {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid region value (expecting to match regular expression: %s)", k, value, awsRegionRegexpPattern))
-	}
-
-	if parsedARN.AccountID != "" && !awsAccountIDRegexp.MatchString(parsedARN.AccountID) {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid account ID value (expecting to match regular expression: %s)", k, value, awsAccountIDRegexpPattern))
-	}
-
-	if parsedARN.Resource == "" {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing resource value", k, value))
-	}
-
-	return ws, errors
+	return tfarn.ValidateFunc(v, k)
 }
 
 func validateEC2AutomateARN(v interface{}, k string) (ws []string, errors []error) {
@@ -995,19 +955,56 @@ func validateDbEventSubscriptionName(v interface{}, k string) (ws []string, erro
 	return
 }
 
-func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error) {
-	// IAM Policy documents need to be valid JSON, and pass legacy parsing
-	value := v.(string)
-	if len(value) < 1 {
-		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy", k))
-		return
+// validateIAMPolicyDocument returns a SchemaValidateFunc which checks that a policy
+// document is valid JSON and would be accepted by IAM, within the size limit of the policy type.
+// Problems with the top-level structure of the document, such as a missing Statement, are
+// returned as warnings. See iamPolicyDocumentErrors.
+func validateIAMPolicyDocument(policyType iamPolicyType) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		// IAM Policy documents need to be valid JSON, and pass legacy parsing
+		value := v.(string)
+		if len(value) < 1 {
+			errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy", k))
+			return
+		}
+		if value[:1] != "{" {
+			errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy", k))
+			return
+		}
+		return validatePolicyDocumentContent(value, k, policyType)
 	}
-	if value[:1] != "{" {
-		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy", k))
-		return
+}
+
+// validateResourcePolicyDocument returns a SchemaValidateFunc which checks that a
+// resource-based policy document is valid JSON and would be accepted by IAM, within
+// the size limit of the policy type. Empty values are allowed, as they remove the policy
+// of some resources. Problems with the top-level structure of the document are returned
+// as warnings. See iamPolicyDocumentErrors.
+func validateResourcePolicyDocument(policyType iamPolicyType) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+		if value == "" {
+			return
+		}
+		return validatePolicyDocumentContent(value, k, policyType)
 	}
-	if _, err := structure.NormalizeJsonString(v); err != nil {
+}
+
+func validatePolicyDocumentContent(value string, k string, policyType iamPolicyType) (ws []string, errors []error) {
+	if _, err := structure.NormalizeJsonString(value); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+	for _, err := range iamPolicyDocumentErrors(value, policyType) {
+		if _, ok := err.(iamPolicyStructureError); ok {
+			ws = append(ws, fmt.Sprintf("%q contains an invalid policy: %s", k, err))
+			continue
+		}
+		errors = append(errors, fmt.Errorf("%q contains an invalid policy: %w", k, err))
 	}
 	return
 }
@@ -2420,24 +2417,6 @@ func validateSecretManagerSecretName(v interface{}, k string) (ws []string, erro
 	return
 }
 
-func validateLbTargetGroupNamePrefix(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	prefixMaxLength := 32 - resource.UniqueIDSuffixLength
-	if len(value) > prefixMaxLength {
-		errors = append(errors, fmt.Errorf(
-			"%q cannot be longer than %d characters", k, prefixMaxLength))
-	}
-	if !regexp.MustCompile(`^[0-9A-Za-z-]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"only alphanumeric characters and hyphens allowed in %q", k))
-	}
-	if regexp.MustCompile(`^-`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q cannot begin with a hyphen", k))
-	}
-	return
-}
-
 func validateSecretManagerSecretNamePrefix(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9A-Za-z/_+=.@-]+$`).MatchString(value) {
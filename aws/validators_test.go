@@ -876,31 +876,77 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 			Value:    `    {"xyz": "foo"}`,
 			ErrCount: 1,
 		},
+		{
+			Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"bucket"}]}`,
+			ErrCount: 1,
+		},
 	}
 
 	for _, tc := range invalidCases {
-		_, errors := validateIAMPolicyJson(tc.Value, "json")
+		_, errors := validateIAMPolicyDocument(iamPolicyTypeManaged)(tc.Value, "json")
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected %q to trigger a validation error.", tc.Value)
 		}
 	}
 
-	validCases := []testCases{
+	validCases := []struct {
+		Value     string
+		WarnCount int
+	}{
 		{
-			Value:    `{}`,
-			ErrCount: 0,
+			Value:     `{}`,
+			WarnCount: 1,
 		},
 		{
-			Value:    `{"abc":["1","2"]}`,
-			ErrCount: 0,
+			Value:     `{"abc":["1","2"]}`,
+			WarnCount: 2,
+		},
+		{
+			Value: `{"Statement":[]}`,
+		},
+		{
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
 		},
 	}
 
 	for _, tc := range validCases {
-		_, errors := validateIAMPolicyJson(tc.Value, "json")
-		if len(errors) != tc.ErrCount {
+		warnings, errors := validateIAMPolicyDocument(iamPolicyTypeManaged)(tc.Value, "json")
+		if len(errors) != 0 {
 			t.Fatalf("Expected %q not to trigger a validation error.", tc.Value)
 		}
+		if len(warnings) != tc.WarnCount {
+			t.Fatalf("Expected %q to trigger %d validation warnings, got %d.", tc.Value, tc.WarnCount, len(warnings))
+		}
+	}
+}
+
+func TestValidateResourcePolicyDocument(t *testing.T) {
+	invalidCases := []string{
+		`{0:"1"}`,
+		`{"xyz":[}}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"bucket"}]}`,
+	}
+
+	for _, v := range invalidCases {
+		_, errors := validateResourcePolicyDocument(iamPolicyTypeS3Bucket)(v, "policy")
+		if len(errors) == 0 {
+			t.Fatalf("Expected %q to trigger a validation error.", v)
+		}
+	}
+
+	validCases := []string{
+		``,
+		`{}`,
+		`{"abc":["1","2"]}`,
+		`    {"Statement":[]}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+	}
+
+	for _, v := range validCases {
+		_, errors := validateResourcePolicyDocument(iamPolicyTypeS3Bucket)(v, "policy")
+		if len(errors) != 0 {
+			t.Fatalf("Expected %q not to trigger a validation error: %q", v, errors)
+		}
 	}
 }
 
@@ -3089,32 +3135,6 @@ func TestValidateLbTargetGroupName(t *testing.T) {
 	}
 }
 
-func TestValidateLbTargetGroupNamePrefix(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		{
-			Value:    "tf.lb",
-			ErrCount: 1,
-		},
-		{
-			Value:    "-tf-lb",
-			ErrCount: 1,
-		},
-		{
-			Value:    acctest.RandStringFromCharSet(32, acctest.CharSetAlpha),
-			ErrCount: 1,
-		},
-	}
-	for _, tc := range cases {
-		_, errors := validateLbTargetGroupNamePrefix(tc.Value, "aws_lb_target_group")
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the AWS LB Target Group Name to trigger a validation error for %q", tc.Value)
-		}
-	}
-}
-
 func TestValidateSecretManagerSecretName(t *testing.T) {
 	cases := []struct {
 		Value    string
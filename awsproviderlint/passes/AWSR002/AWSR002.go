@@ -2,6 +2,9 @@ package AWSR002
 
 import (
 	"go/ast"
+	"go/types"
+	"path/filepath"
+	"strings"
 
 	"github.com/bflad/tfproviderlint/helper/astutils"
 	"github.com/bflad/tfproviderlint/passes/commentignore"
@@ -15,10 +18,20 @@ const Doc = `check for d.Set() of tags attribute that should include IgnoreConfi
 The AWSR002 analyzer reports when a (schema.ResourceData).Set() call with the
 tags key is missing a call to (keyvaluetags.KeyValueTags).IgnoreConfig() in the
 value, which ensures any provider level ignore tags configuration is applied.
+
+In resources, it also reports when the IgnoreConfig() argument is the provider
+level (*AWSClient).IgnoreTagsConfig field instead of the result of
+(*AWSClient).ResourceIgnoreTagsConfig(), which ensures any resource level
+ignore_tags configuration is also applied.
 `
 
 const analyzerName = "AWSR002"
 
+const (
+	fieldNameIgnoreTagsConfig = `IgnoreTagsConfig`
+	resourceFileNamePrefix    = `resource_`
+)
+
 var Analyzer = &analysis.Analyzer{
 	Name: analyzerName,
 	Doc:  Doc,
@@ -48,7 +61,7 @@ func run(pass *analysis.Pass) (interface{}, error) {
 			continue
 		}
 
-		var ignoreConfigCallExprFound bool
+		var ignoreConfigCallExpr *ast.CallExpr
 
 		ast.Inspect(callExpr.Args[1], func(n ast.Node) bool {
 			callExpr, ok := n.(*ast.CallExpr)
@@ -58,18 +71,99 @@ func run(pass *analysis.Pass) (interface{}, error) {
 			}
 
 			if keyvaluetags.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, keyvaluetags.TypeNameKeyValueTags, keyvaluetags.KeyValueTagsMethodNameIgnoreConfig) {
-				ignoreConfigCallExprFound = true
+				ignoreConfigCallExpr = callExpr
 				return false
 			}
 
 			return true
 		})
 
-		if !ignoreConfigCallExprFound {
+		if ignoreConfigCallExpr == nil {
 			pass.Reportf(callExpr.Args[1].Pos(), "%s: missing (keyvaluetags.KeyValueTags).IgnoreConfig()", analyzerName)
+			continue
+		}
+
+		if !isResourceFile(pass, callExpr) || len(ignoreConfigCallExpr.Args) != 1 {
+			continue
 		}
 
+		if isIgnoreTagsConfigField(pass, ignoreConfigCallExpr.Args[0]) {
+			pass.Reportf(ignoreConfigCallExpr.Args[0].Pos(), "%s: use (*AWSClient).ResourceIgnoreTagsConfig() to include resource ignore_tags configuration", analyzerName)
+		}
 	}
 
 	return nil, nil
 }
+
+// isResourceFile returns true if the node is in a resource source file.
+func isResourceFile(pass *analysis.Pass, n ast.Node) bool {
+	file := pass.Fset.File(n.Pos())
+
+	if file == nil {
+		return false
+	}
+
+	return strings.HasPrefix(filepath.Base(file.Name()), resourceFileNamePrefix)
+}
+
+// isIgnoreTagsConfigField returns true if the expression is, or is a variable assigned from,
+// the provider level (*AWSClient).IgnoreTagsConfig field.
+func isIgnoreTagsConfigField(pass *analysis.Pass, e ast.Expr) bool {
+	if ident, ok := e.(*ast.Ident); ok {
+		e = assignedExpr(pass, ident)
+	}
+
+	selectorExpr, ok := e.(*ast.SelectorExpr)
+
+	if !ok {
+		return false
+	}
+
+	selection, ok := pass.TypesInfo.Selections[selectorExpr]
+
+	if !ok || selection.Kind() != types.FieldVal {
+		return false
+	}
+
+	return selection.Obj().Name() == fieldNameIgnoreTagsConfig
+}
+
+// assignedExpr returns the expression a variable is defined with, if any.
+func assignedExpr(pass *analysis.Pass, ident *ast.Ident) ast.Expr {
+	obj := pass.TypesInfo.ObjectOf(ident)
+
+	if obj == nil {
+		return nil
+	}
+
+	var result ast.Expr
+
+	for _, file := range pass.Files {
+		if obj.Pos() < file.Pos() || obj.Pos() > file.End() {
+			continue
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			if result != nil {
+				return false
+			}
+
+			assignStmt, ok := n.(*ast.AssignStmt)
+
+			if !ok || len(assignStmt.Lhs) != len(assignStmt.Rhs) {
+				return true
+			}
+
+			for i, lhs := range assignStmt.Lhs {
+				if lhsIdent, ok := lhs.(*ast.Ident); ok && pass.TypesInfo.Defs[lhsIdent] == obj {
+					result = assignStmt.Rhs[i]
+					return false
+				}
+			}
+
+			return true
+		})
+	}
+
+	return result
+}
@@ -24,8 +24,12 @@ func main() {
 			log.Fatal(err.Error())
 		}
 
+		aws.WriteAPIMetricsSummary()
+
 		return
 	}
 
 	plugin.Serve(opts)
+
+	aws.WriteAPIMetricsSummary()
 }